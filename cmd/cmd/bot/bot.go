@@ -6,8 +6,11 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/warmans/gamesmaster/pkg/discord"
 	"github.com/warmans/gamesmaster/pkg/discord/command"
-	"github.com/warmans/gamesmaster/pkg/discord/command/crossfilm"
 	"github.com/warmans/gamesmaster/pkg/flag"
+	"github.com/warmans/gamesmaster/pkg/picturequiz"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+	"strings"
+	"time"
 
 	"log"
 	"log/slog"
@@ -20,6 +23,8 @@ func NewBotCommand(logger *slog.Logger) *cobra.Command {
 	var discordToken string
 	var botName string
 	var wordsFilePath string
+	var guessLimits ratelimit.Config
+	var imageGameSubmitters string
 
 	cmd := &cobra.Command{
 		Use:   "bot",
@@ -30,12 +35,15 @@ func NewBotCommand(logger *slog.Logger) *cobra.Command {
 			if discordToken == "" {
 				return fmt.Errorf("discord token is required")
 			}
+			if err := guessLimits.Validate(); err != nil {
+				return fmt.Errorf("invalid guess limits: %w", err)
+			}
 			session, err := discordgo.New("Bot " + discordToken)
 			if err != nil {
 				return fmt.Errorf("failed to create discord session: %w", err)
 			}
 
-			scrabble, err := command.NewScrabbleCommand(session, wordsFilePath)
+			scrabble, err := command.NewScrabbleCommand(session, wordsFilePath, guessLimits)
 			if err != nil {
 				return err
 			}
@@ -45,12 +53,12 @@ func NewBotCommand(logger *slog.Logger) *cobra.Command {
 				botName,
 				logger,
 				session,
-				command.NewCrosswordCommand(),
+				command.NewCrosswordCommand(session, guessLimits),
 				command.NewRandomCommand(),
-				command.NewFilmgameCommand(logger, session),
-				crossfilm.NewCrossfilmCommand(logger, session),
+				command.NewPictureQuizCommand(logger, session, guessLimits, picturequiz.Filmgame, nil),
+				command.NewPictureQuizCommand(logger, session, guessLimits, picturequiz.Crossfilm, nil),
 				scrabble,
-				command.NewImageGameCommand(logger, session),
+				command.NewPictureQuizCommand(logger, session, guessLimits, picturequiz.ImageGame, splitList(imageGameSubmitters)),
 			)
 			if err != nil {
 				return fmt.Errorf("failed to create bot: %w", err)
@@ -74,8 +82,23 @@ func NewBotCommand(logger *slog.Logger) *cobra.Command {
 	flag.StringVarEnv(cmd.Flags(), &discordToken, "", "discord-token", "", "discord auth token")
 	flag.StringVarEnv(cmd.Flags(), &botName, "", "bot-name", "gamesmaster", "root command of the bot")
 	flag.StringVarEnv(cmd.Flags(), &wordsFilePath, "", "words-path", "./etc/sowpods.txt", "Path to words list of valid dictionary words")
+	flag.Int64VarEnv(cmd.Flags(), &guessLimits.MaxAttempts, "", "guess-limit", 5, "max guesses a user can make at a single item within the guess-limit-window (0 to disable)")
+	flag.DurationVarEnv(cmd.Flags(), &guessLimits.Window, "", "guess-limit-window", time.Minute, "window in which guesses are counted towards the guess-limit")
+	flag.DurationVarEnv(cmd.Flags(), &guessLimits.Cooldown, "", "guess-cooldown", time.Minute*2, "how long a user must wait after exceeding the guess-limit")
+
+	flag.StringVarEnv(cmd.Flags(), &imageGameSubmitters, "", "imagegame-submitters", "", "comma separated usernames allowed to submit image games from discord")
 
 	flag.Parse()
 
 	return cmd
 }
+
+func splitList(raw string) []string {
+	var out []string
+	for _, v := range strings.Split(raw, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -2,54 +2,106 @@ package crossfilm
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/spf13/cobra"
-	"github.com/warmans/gamesmaster/pkg/crossfilm"
-	"github.com/warmans/gamesmaster/pkg/filmgame"
+	"github.com/warmans/gamesmaster/pkg/clues"
+	"github.com/warmans/gamesmaster/pkg/crossgen"
 	"github.com/warmans/gamesmaster/pkg/flag"
-	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/gamesmaster/pkg/obscure"
+	"github.com/warmans/gamesmaster/pkg/picturequiz"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+	"github.com/warmans/gamesmaster/pkg/render"
 	"github.com/warmans/gamesmaster/pkg/util"
 	"github.com/warmans/go-crossword/v2"
 	"log/slog"
-	"math/rand"
 	"os"
 	"path"
-	"regexp"
-	"slices"
-	"strings"
+	"time"
 )
 
-var dateInParens = regexp.MustCompile(`(\s+)?\([0-9]+\)(\s+)?`)
-var spaces = regexp.MustCompile(`\s+`)
-
 func NewInitCommand(logger *slog.Logger) *cobra.Command {
 
 	var gameStateDir string
+	var duration time.Duration
+	var endsAt string
 	var imagesDir string
+	var manifestPath string
 	var preview bool
+	var maxBoardHeight int64
+	var maxBoardFileSize int64
+	var matchMetric string
+	var matchThreshold float64
+	var closeFeedback bool
+	var guessLimits ratelimit.OverrideFlags
+	var attempts int64
+	var seed int64
+	var requireAll bool
+	var timeBudget time.Duration
+	var obscureMode string
+	var obscureStrength float64
+	var clueLadder string
 
 	cmd := &cobra.Command{
 		Use:   "crossfilm-init",
 		Short: "initialise a new filmgame",
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			if err := renameImages(imagesDir); err != nil {
-				return fmt.Errorf("failed to rename images: %w", err)
+			state, err := picturequiz.NewStateFromManifest(picturequiz.Crossfilm, imagesDir, manifestPath)
+			if err != nil {
+				return err
 			}
-
-			state, err := createStateFromImages(imagesDir)
+			if err := generateCrossword(state, crossgen.Options{
+				GridSize:   30,
+				Attempts:   int(attempts),
+				Seed:       uint64(seed),
+				RequireAll: requireAll,
+				TimeBudget: timeBudget,
+			}); err != nil {
+				return err
+			}
+			ladder, err := clues.Parse(clueLadder)
 			if err != nil {
+				return fmt.Errorf("invalid clue ladder: %w", err)
+			}
+			state.Duration = duration
+			if endsAt != "" {
+				if state.EndsAt, err = time.Parse(time.RFC3339, endsAt); err != nil {
+					return fmt.Errorf("invalid ends-at: %w", err)
+				}
+			}
+			state.Cfg = &picturequiz.Config{
+				Matcher:          &util.Matcher{Metric: util.Metric(matchMetric), Threshold: matchThreshold},
+				CloseFeedback:    closeFeedback,
+				GuessLimits:      guessLimits.Override(),
+				ClueLadder:       ladder,
+				MaxBoardHeight:   int(maxBoardHeight),
+				MaxBoardFileSize: int(maxBoardFileSize),
+			}
+
+			if err := obscure.GenerateAll(imagesDir, state.ObscuredImages(), obscureMode, obscureStrength); err != nil {
 				return err
 			}
 
 			fmt.Println("Rendering...")
-			canvas, err := crossfilm.Render(imagesDir, *state)
+			pages, err := picturequiz.Crossfilm.Renderer.Render(imagesDir, state)
 			if err != nil {
 				return err
 			}
-			if preview {
-				if err := canvas.SavePNG("./crossfilm.png"); err != nil {
-					return err
+			fmt.Printf("Board has %d page(s)\n", len(pages))
+			for _, page := range pages {
+				// fail now rather than when the game starts if a page can't be uploaded
+				if _, _, err := render.Encode(page.Canvas, state.Cfg.BoardFileSize()); err != nil {
+					return fmt.Errorf("page %d: %w", page.Number, err)
+				}
+				if preview {
+					previewName := "./crossfilm.png"
+					if page.Number > 1 {
+						previewName = fmt.Sprintf("./crossfilm-%d.png", page.Number)
+					}
+					if err := page.Canvas.SavePNG(previewName); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -68,47 +120,36 @@ func NewInitCommand(logger *slog.Logger) *cobra.Command {
 
 	flag.StringVarEnv(cmd.Flags(), &gameStateDir, "", "output-dir", "./var/crossfilm/game", "")
 	flag.StringVarEnv(cmd.Flags(), &imagesDir, "", "images-dir", "./var/crossfilm/game/images", "")
+	flag.StringVarEnv(cmd.Flags(), &manifestPath, "", "manifest", "", "JSON or YAML file listing the images and their answers (defaults to manifest.yaml, manifest.yml or manifest.json in the images-dir, otherwise answers are taken from the file names)")
 	flag.BoolVarEnv(cmd.Flags(), &preview, "", "preview", true, "dump an image of the complete crossfilm")
+	flag.Int64VarEnv(cmd.Flags(), &maxBoardHeight, "", "max-board-height", 1800, "maximum height of each page of posters in pixels, larger boards are split into pages")
+	flag.Int64VarEnv(cmd.Flags(), &maxBoardFileSize, "", "max-board-file-size", render.DefaultMaxFileSize, "maximum size of each page of the board in bytes, pages are sent as JPEG if the PNG is too large")
+	flag.StringVarEnv(cmd.Flags(), &matchMetric, "", "match-metric", string(util.MetricLevenshtein), "similarity metric used to check guesses (levenshtein, jaro-winkler, token-set, hamming)")
+	flag.Float64VarEnv(cmd.Flags(), &matchThreshold, "", "match-threshold", util.DefaultMatchThreshold, "minimum similarity (0-1) for a guess to be accepted")
+	flag.BoolVarEnv(cmd.Flags(), &closeFeedback, "", "close-feedback", false, "react differently to guesses that were close or partially right")
+	guessLimits.Register(cmd.Flags())
+	flag.StringVarEnv(cmd.Flags(), &obscureMode, "", "obscure-mode", "", fmt.Sprintf("generate the obscured images with these comma separated modes (%s), leave empty to use existing .blur images", obscure.ModeNames()))
+	flag.Float64VarEnv(cmd.Flags(), &obscureStrength, "", "obscure-strength", 0.6, "how strongly images are obscured from 0 (unchanged) to 1 (barely recognisable)")
+	flag.Int64VarEnv(cmd.Flags(), &attempts, "", "attempts", 500, "number of layouts to try, the best is kept")
+	flag.Int64VarEnv(cmd.Flags(), &seed, "", "seed", 0, "seed of the first attempt, use the reported seed with --attempts=1 to reproduce a layout (0 for random)")
+	flag.BoolVarEnv(cmd.Flags(), &requireAll, "", "require-all", true, "fail if no layout contained all the films")
+	flag.DurationVarEnv(cmd.Flags(), &timeBudget, "", "time-budget", time.Second*30, "stop trying new layouts after this long (0 for no limit)")
+
+	flag.StringVarEnv(cmd.Flags(), &clueLadder, "", "clue-ladder", "", fmt.Sprintf("clues given in order as type:condition=value,... separated by ; e.g. \"first-letter:unsolved=5;pattern:requests=2,reveal=0.5;text:index=0\" (types: %s; conditions: after, unsolved, requests, reveal, index), leave empty to disable clues", clues.TypeNames()))
+
+	flag.DurationVarEnv(cmd.Flags(), &duration, "", "duration", picturequiz.Crossfilm.DefaultDuration, "how long the game runs once it has started")
+	flag.StringVarEnv(cmd.Flags(), &endsAt, "", "ends-at", "", "fixed time the game ends in RFC3339 format e.g. 2024-06-01T18:00:00Z (overrides the duration)")
 
 	flag.Parse()
 
 	return cmd
 }
 
-func createStateFromImages(imagesDir string) (*crossfilm.State, error) {
-	files, err := os.ReadDir(imagesDir)
-	if err != nil {
-		return nil, err
-	}
-	state := &crossfilm.State{
-		FilmgameState: make([]*filmgame.Poster, 0),
-	}
-
-	for _, fd := range files {
-		if fd.IsDir() || strings.Contains(fd.Name(), ".blur.") {
-			continue
-		}
-		name := filmNameFromFilename(fd.Name())
-		state.FilmgameState = append(state.FilmgameState, &filmgame.Poster{
-			OriginalImage: fd.Name(),
-			ObscuredImage: obscuredImageName(fd.Name()),
-			Answer:        name,
-			Guessed:       false,
-		})
-	}
-
-	state.Scores = scores.NewTiered(len(state.FilmgameState))
-
-	slices.SortFunc(state.FilmgameState, func(a, b *filmgame.Poster) int {
-		if rand.Float64() < rand.Float64() {
-			return 1
-		}
-		return -1
-	})
-
+// generateCrossword fills the answers into a crossword, numbered in the same order as the posters.
+func generateCrossword(state *picturequiz.State, genOpts crossgen.Options) error {
 	// build the word list based on the final sorted poster list
 	var words []crossword.Word
-	for k, v := range state.FilmgameState {
+	for k, v := range state.Posters {
 		words = append(
 			words,
 			crossword.Word{
@@ -118,71 +159,20 @@ func createStateFromImages(imagesDir string) (*crossfilm.State, error) {
 		)
 	}
 
-	state.CrosswordState = crossword.Generate(
-		30,
-		words,
-		100,
-		crossword.WithAllAttempts(true),
-	)
-
-	if len(state.CrosswordState.Words) != len(words) {
-		for _, requested := range words {
-			found := false
-			for _, placed := range state.CrosswordState.Words {
-				if requested.Word == placed.Word.Word {
-					found = true
-				}
-			}
-			if !found {
-				fmt.Println(requested.Word, " was not placed")
-			}
-		}
-		return nil, fmt.Errorf("not all words were placed: expected %d got %d", len(words), len(state.CrosswordState.Words))
+	res, err := crossgen.Generate(words, genOpts)
+	if res != nil {
+		fmt.Printf("Best of %d attempts with seed %d (%s)\n", res.Attempts, res.Seed, res.Score)
 	}
-
-	return state, nil
-}
-
-func renameImages(imagesDir string) error {
-	files, err := os.ReadDir(imagesDir)
 	if err != nil {
-		return err
-	}
-	for _, fd := range files {
-		if fd.IsDir() {
-			continue
-		}
-		oldPath := path.Join(imagesDir, fd.Name())
-		newPath := path.Join(imagesDir, fixFileName(fd.Name()))
-		fmt.Printf("Moving %s to %s...\n", oldPath, newPath)
-		if err := os.Rename(oldPath, newPath); err != nil {
-			return fmt.Errorf("failed ot rename %s: %w", fd.Name(), err)
+		if errors.Is(err, crossgen.ErrNotAllPlaced) {
+			for _, v := range crossgen.Unplaced(words, res.Crossword) {
+				fmt.Println(v.Word, " was not placed")
+			}
+			return fmt.Errorf("not all words were placed: expected %d got %d", len(words), len(res.Crossword.Words))
 		}
+		return err
 	}
-	return nil
-}
+	state.Crossword = res.Crossword
 
-func fixFileName(oldName string) string {
-	return strings.ToLower(
-		spaces.ReplaceAllString(
-			spaces.ReplaceAllString(dateInParens.ReplaceAllString(oldName, ""), " "),
-			"-",
-		),
-	)
-}
-
-func obscuredImageName(originalName string) string {
-	extension := path.Ext(originalName)
-	return fmt.Sprintf("%s.blur%s", strings.TrimSuffix(originalName, extension), extension)
-}
-
-func filmNameFromFilename(fileName string) string {
-	return strings.ReplaceAll(
-		strings.TrimSuffix(
-			strings.TrimSuffix(fileName, path.Ext(fileName)),
-			".blur",
-		),
-		"-",
-		" ",
-	)
+	return nil
 }
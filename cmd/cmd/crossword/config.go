@@ -0,0 +1,69 @@
+package crossword
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/warmans/gamesmaster/pkg/crossgen"
+	"github.com/warmans/gamesmaster/pkg/discord/command"
+	"github.com/warmans/gamesmaster/pkg/flag"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+)
+
+// configFlags are the game config flags shared by commands that create a crossword.
+type configFlags struct {
+	closeFeedback    bool
+	maxHintsPerClue  int64
+	hintPenalty      int64
+	hintCooldown     time.Duration
+	duration         time.Duration
+	reminderInterval time.Duration
+	guessLimits      ratelimit.OverrideFlags
+}
+
+func (f *configFlags) register(flagSet *pflag.FlagSet) {
+	flag.BoolVarEnv(flagSet, &f.closeFeedback, "", "close-feedback", false, "react differently to guesses that were close or partially right")
+	flag.Int64VarEnv(flagSet, &f.maxHintsPerClue, "", "max-hints-per-clue", 2, "number of letters that can be revealed for each clue using hints (0 to disable)")
+	flag.Int64VarEnv(flagSet, &f.hintPenalty, "", "hint-penalty", 1, "points deducted from a user for each hint")
+	flag.DurationVarEnv(flagSet, &f.hintCooldown, "", "hint-cooldown", time.Minute*10, "minimum time between hints for the same clue")
+	flag.DurationVarEnv(flagSet, &f.duration, "", "duration", 0, "how long the game runs before it is completed automatically (0 for no limit)")
+	flag.DurationVarEnv(flagSet, &f.reminderInterval, "", "reminder-interval", time.Hour*6, "how often to post the remaining time of a timed game (0 to disable)")
+	f.guessLimits.Register(flagSet)
+}
+
+func (f *configFlags) config() *command.CrosswordConfig {
+	return &command.CrosswordConfig{
+		CloseFeedback:    f.closeFeedback,
+		MaxHintsPerClue:  int(f.maxHintsPerClue),
+		HintPenalty:      int(f.hintPenalty),
+		HintCooldown:     f.hintCooldown,
+		Duration:         f.duration,
+		ReminderInterval: f.reminderInterval,
+		GuessLimits:      f.guessLimits.Override(),
+	}
+}
+
+// generatorFlags control how the crossword layout is generated.
+type generatorFlags struct {
+	attempts   int64
+	seed       int64
+	requireAll bool
+	timeBudget time.Duration
+}
+
+func (f *generatorFlags) register(flagSet *pflag.FlagSet) {
+	flag.Int64VarEnv(flagSet, &f.attempts, "", "attempts", 500, "number of layouts to try, the best is kept")
+	flag.Int64VarEnv(flagSet, &f.seed, "", "seed", 0, "seed of the first attempt, use the reported seed with --attempts=1 to reproduce a layout (0 for random)")
+	flag.BoolVarEnv(flagSet, &f.requireAll, "", "require-all", false, "fail if no layout contained all the words")
+	flag.DurationVarEnv(flagSet, &f.timeBudget, "", "time-budget", time.Second*30, "stop trying new layouts after this long (0 for no limit)")
+}
+
+func (f *generatorFlags) options(gridSize int) crossgen.Options {
+	return crossgen.Options{
+		GridSize:   gridSize,
+		Attempts:   int(f.attempts),
+		Seed:       uint64(f.seed),
+		RequireAll: f.requireAll,
+		TimeBudget: f.timeBudget,
+	}
+}
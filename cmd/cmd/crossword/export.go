@@ -0,0 +1,85 @@
+package crossword
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/warmans/gamesmaster/pkg/discord/command"
+	"github.com/warmans/gamesmaster/pkg/flag"
+	"github.com/warmans/gamesmaster/pkg/puzzle"
+	"github.com/warmans/go-crossword/v2"
+)
+
+func NewExportCommand(logger *slog.Logger) *cobra.Command {
+
+	var gameStatePath string
+	var outputDir string
+	var format string
+	var includeSolution bool
+	var showSolved bool
+
+	cmd := &cobra.Command{
+		Use:   "crossword-export",
+		Short: "export a crossword as ipuz and/or a printable SVG",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			f, err := os.Open(gameStatePath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			cw := &command.CrosswordState{}
+			if err := json.NewDecoder(f).Decode(cw); err != nil {
+				return err
+			}
+
+			opts := puzzle.ExportOptions{
+				Title:           cw.ThreadTitle,
+				IncludeSolution: includeSolution,
+				ShowSolved:      showSolved,
+			}
+			name := strings.TrimSuffix(path.Base(gameStatePath), path.Ext(gameStatePath))
+
+			writers := map[string]func(io.Writer, *crossword.Crossword, puzzle.ExportOptions) error{
+				"ipuz": puzzle.WriteIPuz,
+				"svg":  puzzle.WriteSVG,
+			}
+			for ext, write := range writers {
+				if format != "all" && format != ext {
+					continue
+				}
+				outPath := path.Join(outputDir, fmt.Sprintf("%s.%s", name, ext))
+				out, err := os.Create(outPath)
+				if err != nil {
+					return err
+				}
+				if err := write(out, cw.Game, opts); err != nil {
+					out.Close()
+					return fmt.Errorf("failed to write %s: %w", outPath, err)
+				}
+				if err := out.Close(); err != nil {
+					return err
+				}
+				fmt.Println("Wrote " + outPath)
+			}
+			return nil
+		},
+	}
+
+	flag.StringVarEnv(cmd.Flags(), &gameStatePath, "", "game-state", "./var/crossword/game/current.json", "")
+	flag.StringVarEnv(cmd.Flags(), &outputDir, "", "output-dir", "./var/crossword/export", "")
+	flag.StringVarEnv(cmd.Flags(), &format, "", "format", "all", "export format (ipuz, svg or all)")
+	flag.BoolVarEnv(cmd.Flags(), &includeSolution, "", "solution", false, "include the solution")
+	flag.BoolVarEnv(cmd.Flags(), &showSolved, "", "show-solved", true, "show letters already solved in the game")
+
+	flag.Parse()
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package crossword
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+
+	"github.com/spf13/cobra"
+	"github.com/warmans/gamesmaster/pkg/discord/command"
+	"github.com/warmans/gamesmaster/pkg/flag"
+	"github.com/warmans/gamesmaster/pkg/puzzle"
+	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/go-crossword/v2"
+)
+
+func NewImportCommand(logger *slog.Logger) *cobra.Command {
+
+	var gameStateDir string
+	var outputName string
+	var puzzlePath string
+	var preview bool
+	var cfgFlags configFlags
+
+	cmd := &cobra.Command{
+		Use:   "crossword-import",
+		Short: "initialise a new crossword from a .puz or ipuz file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if puzzlePath == "" {
+				return fmt.Errorf("puzzle path is required")
+			}
+			p, err := puzzle.Load(puzzlePath)
+			if err != nil {
+				return fmt.Errorf("failed to load puzzle: %w", err)
+			}
+			cw, err := p.ToCrossword()
+			if err != nil {
+				return fmt.Errorf("invalid puzzle: %w", err)
+			}
+
+			if preview {
+				canvas, err := command.RenderCrossword(cw, crossword.WithAllSolved(true))
+				if err != nil {
+					return err
+				}
+				if err := canvas.SavePNG("crossword.png"); err != nil {
+					return err
+				}
+			}
+			fmt.Print(crossword.RenderText(cw, crossword.WithAllSolved(true)))
+			fmt.Printf("\nImported %s: %d words\n", p.Title, len(cw.Words))
+
+			gameState, err := os.Create(path.Join(gameStateDir, outputName))
+			if err != nil {
+				return err
+			}
+			defer gameState.Close()
+
+			enc := json.NewEncoder(gameState)
+			enc.SetIndent("", "    ")
+			return enc.Encode(&command.CrosswordState{
+				ThreadTitle: p.Title,
+				Cfg:         cfgFlags.config(),
+				Game:        cw,
+				Scores:      scores.NewTiered(len(cw.Words)),
+			})
+		},
+	}
+
+	flag.StringVarEnv(cmd.Flags(), &gameStateDir, "", "output-dir", "./var/crossword/game", "")
+	flag.StringVarEnv(cmd.Flags(), &outputName, "", "output-name", "current.json", "name of the game state file")
+	flag.StringVarEnv(cmd.Flags(), &puzzlePath, "", "puzzle", "", "path to a .puz or .ipuz file")
+	flag.BoolVarEnv(cmd.Flags(), &preview, "", "preview", true, "dump an image of the complete crossword")
+	cfgFlags.register(cmd.Flags())
+
+	flag.Parse()
+
+	return cmd
+}
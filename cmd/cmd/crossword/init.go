@@ -2,15 +2,20 @@ package crossword
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/warmans/gamesmaster/pkg/crossgen"
 	"github.com/warmans/gamesmaster/pkg/discord/command"
 	"github.com/warmans/gamesmaster/pkg/flag"
 	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/gamesmaster/pkg/util"
+	"github.com/warmans/gamesmaster/pkg/wordlist"
 	"github.com/warmans/go-crossword/v2"
 )
 
@@ -21,36 +26,55 @@ func NewInitCommand(logger *slog.Logger) *cobra.Command {
 	var gameStateDir string
 	var wordListPath string
 	var preview bool
+	var themeName string
+	var themeSize int64
+	var answerTemplate string
+	var clueTemplate string
+	var cfgFlags configFlags
+	var genFlags generatorFlags
 
 	cmd := &cobra.Command{
 		Use:   "crossword-init",
 		Short: "initialise a new crossword",
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			f, err := os.Open(wordListPath)
-			if err != nil {
-				return err
+			var words []crossword.Word
+			if themeName != "" {
+				theme, err := wordlist.LoadTheme(themeName)
+				if err != nil {
+					return err
+				}
+				theme.Answer = util.IfEmpty(answerTemplate, theme.Answer)
+				theme.Clue = util.IfEmpty(clueTemplate, theme.Clue)
+
+				rows, err := theme.Rows(int(themeSize), GridSize)
+				if err != nil {
+					return err
+				}
+				words = wordlist.Words(rows)
+			} else {
+				f, err := os.Open(wordListPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				if err := json.NewDecoder(f).Decode(&words); err != nil {
+					return err
+				}
 			}
-			defer f.Close()
 
-			words := []crossword.Word{}
-			if err := json.NewDecoder(f).Decode(&words); err != nil {
+			res, err := crossgen.Generate(words, genFlags.options(GridSize))
+			if err != nil && !errors.Is(err, crossgen.ErrNotAllPlaced) {
 				return err
 			}
-
-			for _, v := range words {
-				if len(v.Word) > GridSize {
-					return fmt.Errorf("word list contained workd longer than grid size (%d): %s", GridSize, v.Word)
-				}
+			cw := res.Crossword
+			fmt.Printf("Best of %d attempts with seed %d (%s)\n", res.Attempts, res.Seed, res.Score)
+			if err != nil {
+				printUnplaced(words, cw)
+				return err
 			}
 
-			cw := crossword.Generate(
-				GridSize,
-				words,
-				100,
-				crossword.WithRevealFirstLetterOfEachWord(false),
-				crossword.WithAllAttempts(true),
-			)
 			canvas, err := command.RenderCrossword(cw, crossword.WithAllSolved(true))
 			if err != nil {
 				return err
@@ -73,31 +97,38 @@ func NewInitCommand(logger *slog.Logger) *cobra.Command {
 			fmt.Print(crossword.RenderText(cw, crossword.WithAllSolved(false)))
 			fmt.Printf("\nInput words: %d\nPlaced Words: %d\n", len(words), len(cw.Words))
 
-			if len(words) > len(cw.Words) {
-				fmt.Println("\nUnplaced:")
-				for _, v := range words {
-					found := false
-					for _, vv := range cw.Words {
-						if v.Word == vv.Word.Word {
-							found = true
-							break
-						}
-					}
-					if !found {
-						fmt.Println("- " + v.Word)
-					}
-				}
-			}
+			printUnplaced(words, cw)
 
-			return enc.Encode(&command.CrosswordState{Game: cw, Scores: scores.NewTiered(len(cw.Words))})
+			return enc.Encode(&command.CrosswordState{
+				Cfg:    cfgFlags.config(),
+				Game:   cw,
+				Scores: scores.NewTiered(len(cw.Words)),
+			})
 		},
 	}
 
 	flag.StringVarEnv(cmd.Flags(), &gameStateDir, "", "output-dir", "./var/crossword/game", "")
 	flag.StringVarEnv(cmd.Flags(), &wordListPath, "", "word-list", "./var/crossword/wordlist/current.json", "")
 	flag.BoolVarEnv(cmd.Flags(), &preview, "", "preview", true, "dump an image of the complete crossword")
+	flag.StringVarEnv(cmd.Flags(), &themeName, "", "theme", "", fmt.Sprintf("build the word list from a builtin theme (%s) or a JSON/CSV themed list instead of the word-list", strings.Join(wordlist.BuiltinThemeNames(), ", ")))
+	flag.Int64VarEnv(cmd.Flags(), &themeSize, "", "theme-size", 20, "number of words to pick from the theme")
+	flag.StringVarEnv(cmd.Flags(), &answerTemplate, "", "answer-template", "", "template for answers from the theme e.g. {{.Artist}} (defaults to the theme's template)")
+	flag.StringVarEnv(cmd.Flags(), &clueTemplate, "", "clue-template", "", "template for clues from the theme e.g. \"Artist of '{{.Song}}'\" (defaults to the theme's template)")
+	cfgFlags.register(cmd.Flags())
+	genFlags.register(cmd.Flags())
 
 	flag.Parse()
 
 	return cmd
 }
+
+func printUnplaced(words []crossword.Word, cw *crossword.Crossword) {
+	unplaced := crossgen.Unplaced(words, cw)
+	if len(unplaced) == 0 {
+		return
+	}
+	fmt.Println("\nUnplaced:")
+	for _, v := range unplaced {
+		fmt.Println("- " + v.Word)
+	}
+}
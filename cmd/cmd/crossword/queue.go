@@ -0,0 +1,90 @@
+package crossword
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/warmans/gamesmaster/pkg/discord/command"
+	"github.com/warmans/gamesmaster/pkg/flag"
+)
+
+func NewQueueCommand(logger *slog.Logger) *cobra.Command {
+
+	var guildID string
+	var gameStatePath string
+	var queueDir string
+	var list bool
+
+	cmd := &cobra.Command{
+		Use:   "crossword-queue",
+		Short: "add a generated crossword to a guild's daily queue",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if guildID == "" {
+				return fmt.Errorf("guild ID is required")
+			}
+			q := command.CrosswordQueue(queueDir, guildID)
+
+			if !list {
+				state, err := loadQueueableState(gameStatePath)
+				if err != nil {
+					return err
+				}
+				name, err := q.Push(state.ThreadTitle, state)
+				if err != nil {
+					return fmt.Errorf("failed to queue crossword: %w", err)
+				}
+				fmt.Printf("Queued %s\n", name)
+			}
+
+			names, err := q.List()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d crosswords queued for guild %s:\n", len(names), guildID)
+			for k, v := range names {
+				fmt.Printf("%d. %s\n", k+1, v)
+			}
+			return nil
+		},
+	}
+
+	flag.StringVarEnv(cmd.Flags(), &guildID, "", "guild-id", "", "the guild the crossword will be played in")
+	flag.StringVarEnv(cmd.Flags(), &gameStatePath, "", "game-state", "./var/crossword/game/current.json", "crossword created by crossword-init or crossword-import")
+	flag.StringVarEnv(cmd.Flags(), &queueDir, "", "queue-dir", "./var/crossword/queue", "")
+	flag.BoolVarEnv(cmd.Flags(), &list, "", "list", false, "only list the queued crosswords")
+
+	flag.Parse()
+
+	return cmd
+}
+
+// loadQueueableState loads a game state and clears anything left over from a previous start so it
+// can be started by the scheduler.
+func loadQueueableState(gameStatePath string) (*command.CrosswordState, error) {
+	f, err := os.Open(gameStatePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	state := &command.CrosswordState{}
+	if err := json.NewDecoder(f).Decode(state); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", gameStatePath, err)
+	}
+	if state.Game == nil || len(state.Game.Words) == 0 {
+		return nil, fmt.Errorf("%s does not contain a crossword", gameStatePath)
+	}
+	if state.Complete {
+		return nil, fmt.Errorf("%s is already complete", gameStatePath)
+	}
+	state.AnswerThreadID = ""
+	state.OriginalMessageID = ""
+	state.OriginalMessageChannel = ""
+	state.StartedAt = time.Time{}
+	state.LastReminder = time.Time{}
+	return state, nil
+}
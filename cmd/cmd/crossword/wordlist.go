@@ -2,9 +2,12 @@ package crossword
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/spf13/cobra"
+	"github.com/warmans/gamesmaster/pkg/dictionary"
 	"github.com/warmans/gamesmaster/pkg/flag"
+	"github.com/warmans/gamesmaster/pkg/wordlist"
 	"github.com/warmans/go-crossword/v2"
 	"os"
 	"strings"
@@ -52,3 +55,81 @@ func NewRandomWordListCommand(logger *slog.Logger) *cobra.Command {
 
 	return cmd
 }
+
+func NewWordListCommand(logger *slog.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crossword-wordlist",
+		Short: "manage crossword word lists",
+	}
+	cmd.AddCommand(newWordListImportCommand(logger))
+	return cmd
+}
+
+func newWordListImportCommand(logger *slog.Logger) *cobra.Command {
+
+	var inputPath string
+	var format string
+	var wordListPath string
+	var wordsFilePath string
+	var gridSize int64
+	var strict bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "create a word list from a CSV, TSV or Markdown table of answers and clues",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputPath == "" {
+				return fmt.Errorf("input path is required")
+			}
+			listFormat := wordlist.Format(format)
+			if listFormat == "" {
+				var err error
+				if listFormat, err = wordlist.FormatFromPath(inputPath); err != nil {
+					return err
+				}
+			}
+			rows, err := wordlist.Load(inputPath, listFormat)
+			if err != nil {
+				return fmt.Errorf("failed to read word list: %w", err)
+			}
+
+			var dict dictionary.Words
+			if wordsFilePath != "" {
+				if dict, err = dictionary.LoadWords(wordsFilePath); err != nil {
+					return err
+				}
+			}
+
+			issues := wordlist.Validate(rows, int(gridSize), dict)
+			for _, v := range issues {
+				fmt.Println(v.String())
+			}
+			if wordlist.HasErrors(issues) || (strict && len(issues) > 0) {
+				return fmt.Errorf("word list has %d issues", len(issues))
+			}
+
+			f, err := os.Create(wordListPath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			fmt.Printf("Imported %d words to %s\n", len(rows), wordListPath)
+
+			enc := json.NewEncoder(f)
+			enc.SetIndent("", "    ")
+			return enc.Encode(wordlist.Words(rows))
+		},
+	}
+
+	flag.StringVarEnv(cmd.Flags(), &inputPath, "", "input", "", "path to a CSV, TSV or Markdown table with answer and clue columns")
+	flag.StringVarEnv(cmd.Flags(), &format, "", "format", "", "format of the input (csv, tsv, md), guessed from the extension if empty")
+	flag.StringVarEnv(cmd.Flags(), &wordListPath, "", "word-list", "./var/crossword/wordlist/current.json", "")
+	flag.StringVarEnv(cmd.Flags(), &wordsFilePath, "", "words-path", "./etc/sowpods.txt", "dictionary used to flag unknown words (empty to skip)")
+	flag.Int64VarEnv(cmd.Flags(), &gridSize, "", "grid-size", GridSize, "")
+	flag.BoolVarEnv(cmd.Flags(), &strict, "", "strict", false, "also fail on warnings e.g. unknown words and clues containing the answer")
+
+	flag.Parse()
+
+	return cmd
+}
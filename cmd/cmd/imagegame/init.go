@@ -4,31 +4,43 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/spf13/cobra"
+	"github.com/warmans/gamesmaster/pkg/clues"
 	"github.com/warmans/gamesmaster/pkg/flag"
-	"github.com/warmans/gamesmaster/pkg/imagegame"
-	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/gamesmaster/pkg/obscure"
+	"github.com/warmans/gamesmaster/pkg/picturequiz"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+	"github.com/warmans/gamesmaster/pkg/render"
+	"github.com/warmans/gamesmaster/pkg/util"
 	"log/slog"
-	"math/rand"
 	"os"
 	"path"
-	"regexp"
-	"slices"
-	"strings"
+	"time"
 )
 
-var dateInParens = regexp.MustCompile(`(\s+)?\([0-9]+\)(\s+)?`)
-var spaces = regexp.MustCompile(`\s+`)
-
 func NewInitCommand(logger *slog.Logger) *cobra.Command {
 
 	var imagesDir string
+	var manifestPath string
 	var gameStateDir string
+	var duration time.Duration
+	var endsAt string
 	var guildID string
 	var gameName string
 	var imageWidth int64
 	var imageHeight int64
 	var preview bool
+	var maxBoardWidth int64
+	var maxBoardHeight int64
+	var maxBoardFileSize int64
 	var requireAlternatingUsers bool
+	var matchMetric string
+	var matchThreshold float64
+	var closeFeedback bool
+	var guessLimits ratelimit.OverrideFlags
+	var revealStages int64
+	var revealMode string
+	var revealWrongGuesses int64
+	var clueLadder string
 
 	cmd := &cobra.Command{
 		Use:   "imagegame-init",
@@ -41,29 +53,65 @@ func NewInitCommand(logger *slog.Logger) *cobra.Command {
 				return fmt.Errorf("-guild-id is required")
 			}
 
-			if err := renameImages(imagesDir); err != nil {
-				return fmt.Errorf("failed to rename images: %w", err)
-			}
-
-			state, err := createStateFromImages(imagesDir, gameName, guildID)
+			state, err := picturequiz.NewStateFromManifest(picturequiz.ImageGame, imagesDir, manifestPath)
 			if err != nil {
 				return err
 			}
+			state.GameTitle = gameName
+			state.GuildID = guildID
 
-			state.Cfg = &imagegame.Config{
+			ladder, err := clues.Parse(clueLadder)
+			if err != nil {
+				return fmt.Errorf("invalid clue ladder: %w", err)
+			}
+			state.Duration = duration
+			if endsAt != "" {
+				if state.EndsAt, err = time.Parse(time.RFC3339, endsAt); err != nil {
+					return fmt.Errorf("invalid ends-at: %w", err)
+				}
+			}
+			state.Cfg = &picturequiz.Config{
 				ImagesWidth:             imageWidth,
 				ImagesHeight:            imageHeight,
 				RequireAlternatingUsers: requireAlternatingUsers,
+				Matcher:                 &util.Matcher{Metric: util.Metric(matchMetric), Threshold: matchThreshold},
+				WrongGuessesPerStage:    int(revealWrongGuesses),
+				CloseFeedback:           closeFeedback,
+				GuessLimits:             guessLimits.Override(),
+				ClueLadder:              ladder,
+				MaxBoardWidth:           int(maxBoardWidth),
+				MaxBoardHeight:          int(maxBoardHeight),
+				MaxBoardFileSize:        int(maxBoardFileSize),
+			}
+
+			if revealStages > 0 {
+				fmt.Printf("Generating %d reveal stages...\n", revealStages)
+				for _, v := range state.Posters {
+					if v.Stages, err = obscure.GenerateStages(imagesDir, v.OriginalImage, obscure.Mode(revealMode), int(revealStages)); err != nil {
+						return err
+					}
+				}
 			}
 
 			fmt.Println("Rendering...")
-			canvas, err := imagegame.Render(imagesDir, state)
+			pages, err := picturequiz.ImageGame.Renderer.Render(imagesDir, state)
 			if err != nil {
 				return err
 			}
-			if preview {
-				if err := canvas.SavePNG("./imagegame.png"); err != nil {
-					return err
+			fmt.Printf("Board has %d page(s)\n", len(pages))
+			for _, page := range pages {
+				// fail now rather than when the game starts if a page can't be uploaded
+				if _, _, err := render.Encode(page.Canvas, state.Cfg.BoardFileSize()); err != nil {
+					return fmt.Errorf("page %d: %w", page.Number, err)
+				}
+				if preview {
+					previewName := "./imagegame.png"
+					if page.Number > 1 {
+						previewName = fmt.Sprintf("./imagegame-%d.png", page.Number)
+					}
+					if err := page.Canvas.SavePNG(previewName); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -82,86 +130,30 @@ func NewInitCommand(logger *slog.Logger) *cobra.Command {
 
 	flag.StringVarEnv(cmd.Flags(), &gameStateDir, "", "output-dir", "./var/imagegame/game", "")
 	flag.StringVarEnv(cmd.Flags(), &imagesDir, "", "images-dir", "./var/imagegame/game/images", "")
+	flag.StringVarEnv(cmd.Flags(), &manifestPath, "", "manifest", "", "JSON or YAML file listing the images and their answers (defaults to manifest.yaml, manifest.yml or manifest.json in the images-dir, otherwise answers are taken from the file names)")
 	flag.StringVarEnv(cmd.Flags(), &guildID, "", "guild-id", "", "")
 	flag.BoolVarEnv(cmd.Flags(), &preview, "", "preview", true, "dump an image of the complete crossword")
+	flag.Int64VarEnv(cmd.Flags(), &maxBoardWidth, "", "max-board-width", render.DefaultMaxWidth, "maximum width of each page of the board in pixels, fewer images are shown per row if needed")
+	flag.Int64VarEnv(cmd.Flags(), &maxBoardHeight, "", "max-board-height", render.DefaultMaxHeight, "maximum height of each page of the board in pixels, larger boards are split into pages")
+	flag.Int64VarEnv(cmd.Flags(), &maxBoardFileSize, "", "max-board-file-size", render.DefaultMaxFileSize, "maximum size of each page of the board in bytes, pages are sent as JPEG if the PNG is too large")
 	flag.StringVarEnv(cmd.Flags(), &gameName, "", "name", "", "name to give the game")
 	flag.Int64VarEnv(cmd.Flags(), &imageWidth, "", "image-width", 200, "image width")
 	flag.Int64VarEnv(cmd.Flags(), &imageHeight, "", "image-height", 300, "image height")
 	flag.BoolVarEnv(cmd.Flags(), &requireAlternatingUsers, "", "require-alternating-users", false, "prevent same user answering multiple questions in a row")
+	flag.StringVarEnv(cmd.Flags(), &matchMetric, "", "match-metric", string(util.MetricLevenshtein), "similarity metric used to check guesses (levenshtein, jaro-winkler, token-set, hamming)")
+	flag.Float64VarEnv(cmd.Flags(), &matchThreshold, "", "match-threshold", util.DefaultMatchThreshold, "minimum similarity (0-1) for a guess to be accepted")
+	flag.BoolVarEnv(cmd.Flags(), &closeFeedback, "", "close-feedback", false, "react differently to guesses that were close or partially right")
+	guessLimits.Register(cmd.Flags())
+	flag.Int64VarEnv(cmd.Flags(), &revealStages, "", "reveal-stages", 0, "number of progressively clearer images to reveal over the game (0 to disable)")
+	flag.StringVarEnv(cmd.Flags(), &revealMode, "", "reveal-mode", string(obscure.ModePixelate), fmt.Sprintf("how reveal stages are obscured (%s)", obscure.ModeNames()))
+	flag.Int64VarEnv(cmd.Flags(), &revealWrongGuesses, "", "reveal-wrong-guesses", 0, "also reveal the next stage of an item after this many wrong guesses (0 to only reveal over time)")
 
-	flag.Parse()
+	flag.StringVarEnv(cmd.Flags(), &clueLadder, "", "clue-ladder", "", fmt.Sprintf("clues given in order as type:condition=value,... separated by ; e.g. \"first-letter:unsolved=5;pattern:requests=2,reveal=0.5;text:index=0\" (types: %s; conditions: after, unsolved, requests, reveal, index), leave empty for the default", clues.TypeNames()))
 
-	return cmd
-}
+	flag.DurationVarEnv(cmd.Flags(), &duration, "", "duration", picturequiz.ImageGame.DefaultDuration, "how long the game runs once it has started")
+	flag.StringVarEnv(cmd.Flags(), &endsAt, "", "ends-at", "", "fixed time the game ends in RFC3339 format e.g. 2024-06-01T18:00:00Z (overrides the duration)")
 
-func createStateFromImages(imagesDir string, gameTitle string, guildID string) (*imagegame.State, error) {
-	files, err := os.ReadDir(imagesDir)
-	if err != nil {
-		return nil, err
-	}
-	state := &imagegame.State{
-		Posters:   make([]*imagegame.Image, 0),
-		GameTitle: gameTitle,
-		GuildID:   guildID,
-	}
-	for _, fd := range files {
-		if fd.IsDir() || strings.Contains(fd.Name(), ".blur.") {
-			continue
-		}
-		state.Posters = append(state.Posters, &imagegame.Image{
-			Path:    fd.Name(),
-			Answer:  filmNameFromFilename(fd.Name()),
-			Guessed: false,
-		})
-	}
-
-	state.Scores = scores.NewTiered(len(state.Posters))
-
-	slices.SortFunc(state.Posters, func(a, b *imagegame.Image) int {
-		if rand.Float64() < rand.Float64() {
-			return 1
-		}
-		return -1
-	})
-
-	return state, nil
-}
-
-func renameImages(imagesDir string) error {
-	files, err := os.ReadDir(imagesDir)
-	if err != nil {
-		return err
-	}
-	for _, fd := range files {
-		if fd.IsDir() {
-			continue
-		}
-		oldPath := path.Join(imagesDir, fd.Name())
-		newPath := path.Join(imagesDir, fixFileName(fd.Name()))
-		fmt.Printf("Moving %s to %s...\n", oldPath, newPath)
-		if err := os.Rename(oldPath, newPath); err != nil {
-			return fmt.Errorf("failed ot rename %s: %w", fd.Name(), err)
-		}
-	}
-	return nil
-}
-
-func fixFileName(oldName string) string {
-	return strings.ToLower(
-		spaces.ReplaceAllString(
-			spaces.ReplaceAllString(dateInParens.ReplaceAllString(oldName, ""), " "),
-			"-",
-		),
-	)
-}
+	flag.Parse()
 
-func filmNameFromFilename(fileName string) string {
-	return strings.ReplaceAll(
-		strings.TrimSuffix(
-			strings.TrimSuffix(fileName, path.Ext(fileName)),
-			".blur",
-		),
-		"-",
-		" ",
-	)
+	return cmd
 }
@@ -26,7 +26,11 @@ func Execute(logger *slog.Logger) error {
 	rootCmd.AddCommand(bot.NewBotCommand(logger))
 	rootCmd.AddCommand(crossword.NewInitCommand(logger))
 	rootCmd.AddCommand(crossword.NewRandomWordListCommand(logger))
+	rootCmd.AddCommand(crossword.NewWordListCommand(logger))
 	rootCmd.AddCommand(crossword.NewLoadCommand(logger))
+	rootCmd.AddCommand(crossword.NewImportCommand(logger))
+	rootCmd.AddCommand(crossword.NewExportCommand(logger))
+	rootCmd.AddCommand(crossword.NewQueueCommand(logger))
 	rootCmd.AddCommand(filmgame.NewInitCommand(logger))
 	rootCmd.AddCommand(crossfilm.NewInitCommand(logger))
 	rootCmd.AddCommand(imagegame.NewInitCommand(logger))
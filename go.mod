@@ -13,6 +13,8 @@ require (
 	github.com/warmans/go-crossword/v2 v2.1.0
 	github.com/warmans/go-scrabble v1.2.5
 	golang.org/x/image v0.39.0
+	golang.org/x/text v0.36.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
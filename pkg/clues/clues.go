@@ -0,0 +1,250 @@
+package clues
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type StepType string
+
+const (
+	StepLetterCount StepType = "letters"
+	StepFirstLetter StepType = "first-letter"
+	StepInitials    StepType = "initials"
+	StepPattern     StepType = "pattern"
+	StepText        StepType = "text"
+)
+
+// StepTypes are all the supported step types.
+var StepTypes = []StepType{StepLetterCount, StepFirstLetter, StepInitials, StepPattern, StepText}
+
+// TypeNames returns the supported step types as a comma separated list for help text.
+func TypeNames() string {
+	names := make([]string, len(StepTypes))
+	for k, v := range StepTypes {
+		names[k] = string(v)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Step is one rung of the ladder. All the unlock conditions that are set must be met before the step is given.
+type Step struct {
+	Type StepType
+	// After unlocks the step once the game has been running this long.
+	After time.Duration
+	// MaxUnsolved unlocks the step once this many or fewer items are unsolved (0 to ignore).
+	MaxUnsolved int
+	// Requests unlocks the step once a player has asked for a clue for the item this many times (0 to ignore).
+	Requests int
+	// Reveal is the fraction of letters shown by a pattern step.
+	Reveal float64
+	// Index selects which of the item's custom clues is shown by a text step.
+	Index int
+}
+
+// Progress is the state of the game used to check the unlock conditions.
+type Progress struct {
+	Elapsed  time.Duration
+	Unsolved int
+	// Requests is the number of times the player has asked for a clue for the item, including this one.
+	Requests int
+}
+
+func (s Step) Unlocked(p Progress) bool {
+	if s.After > 0 && p.Elapsed < s.After {
+		return false
+	}
+	if s.MaxUnsolved > 0 && p.Unsolved > s.MaxUnsolved {
+		return false
+	}
+	if s.Requests > 0 && p.Requests < s.Requests {
+		return false
+	}
+	return true
+}
+
+// Text returns the clue for the answer or false if the step has nothing to show e.g. a text step for an
+// item without custom clues.
+func (s Step) Text(answer string, custom []string) (string, bool) {
+	switch s.Type {
+	case StepLetterCount:
+		var counts []string
+		for _, w := range strings.Fields(answer) {
+			counts = append(counts, strconv.Itoa(len([]rune(w))))
+		}
+		return fmt.Sprintf("letters: (%s)", strings.Join(counts, ",")), true
+	case StepFirstLetter:
+		for _, r := range answer {
+			if !unicode.IsSpace(r) {
+				return fmt.Sprintf("starts with: %s", strings.ToUpper(string(r))), true
+			}
+		}
+	case StepInitials:
+		initials := ""
+		for _, w := range strings.Fields(answer) {
+			if r := []rune(w)[0]; !unicode.IsNumber(r) {
+				initials += strings.ToUpper(string(r))
+			}
+		}
+		return fmt.Sprintf("initials: %s", initials), true
+	case StepPattern:
+		return fmt.Sprintf("pattern: `%s`", Pattern(answer, s.Reveal)), true
+	case StepText:
+		if s.Index >= 0 && s.Index < len(custom) && custom[s.Index] != "" {
+			return fmt.Sprintf("hint: %s", custom[s.Index]), true
+		}
+	}
+	return "", false
+}
+
+func (s Step) String() string {
+	var conditions []string
+	if s.After > 0 {
+		conditions = append(conditions, fmt.Sprintf("after %s", s.After))
+	}
+	if s.MaxUnsolved > 0 {
+		conditions = append(conditions, fmt.Sprintf("when %d or fewer are left", s.MaxUnsolved))
+	}
+	if s.Requests > 0 {
+		conditions = append(conditions, fmt.Sprintf("on your request #%d", s.Requests))
+	}
+	name := strings.ReplaceAll(string(s.Type), "-", " ")
+	if s.Type == StepText {
+		name = "hint"
+	}
+	if len(conditions) == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, strings.Join(conditions, ", "))
+}
+
+// Ladder is a list of clues given in order. Each request gives the last step that is unlocked where
+// all the previous steps are also unlocked.
+type Ladder []Step
+
+// Next returns the index of the clue to give and its text. If no step is unlocked the index is -1.
+func (l Ladder) Next(p Progress, answer string, custom []string) (int, string) {
+	idx, text := -1, ""
+	for k, v := range l {
+		if !v.Unlocked(p) {
+			break
+		}
+		if t, ok := v.Text(answer, custom); ok {
+			idx, text = k, t
+		}
+	}
+	return idx, text
+}
+
+func (l Ladder) String() string {
+	steps := make([]string, len(l))
+	for k, v := range l {
+		steps[k] = v.String()
+	}
+	return strings.Join(steps, " → ")
+}
+
+// Pattern shows the given fraction of letters in each word e.g. "The Thing" with 0.5 => "Th_ Th_n_". The first letter
+// of each word is always shown if any letters are revealed, then letters are revealed evenly through the word.
+func Pattern(answer string, reveal float64) string {
+	reveal = min(1, max(0, reveal))
+	var words []string
+	for _, w := range strings.Fields(answer) {
+		letters := []rune(w)
+		show := int(float64(len(letters))*reveal + 0.5)
+		out := make([]rune, len(letters))
+		for k, r := range letters {
+			out[k] = '_'
+			if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+				out[k] = r
+			}
+		}
+		for i := range show {
+			k := i * len(letters) / show
+			out[k] = letters[k]
+		}
+		words = append(words, string(out))
+	}
+	return strings.Join(words, " ")
+}
+
+// Parse reads a ladder from a spec where steps are separated by semicolons and each step is a type followed by
+// optional conditions e.g. "first-letter:unsolved=5;initials:after=12h;pattern:requests=2,reveal=0.5;text:index=0".
+func Parse(spec string) (Ladder, error) {
+	var ladder Ladder
+	for _, rawStep := range strings.Split(spec, ";") {
+		rawStep = strings.TrimSpace(rawStep)
+		if rawStep == "" {
+			continue
+		}
+		stepType, rawConditions, _ := strings.Cut(rawStep, ":")
+		step := Step{Type: StepType(strings.TrimSpace(stepType)), Reveal: 0.5}
+		if !slices.Contains(StepTypes, step.Type) {
+			return nil, fmt.Errorf("unknown clue type: %s", step.Type)
+		}
+		for _, condition := range strings.Split(rawConditions, ",") {
+			if strings.TrimSpace(condition) == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(condition, "=")
+			if !ok {
+				return nil, fmt.Errorf("%s: expected key=value got %s", step.Type, condition)
+			}
+			var err error
+			switch strings.TrimSpace(key) {
+			case "after":
+				step.After, err = time.ParseDuration(strings.TrimSpace(value))
+			case "unsolved":
+				step.MaxUnsolved, err = strconv.Atoi(strings.TrimSpace(value))
+			case "requests":
+				step.Requests, err = strconv.Atoi(strings.TrimSpace(value))
+			case "reveal":
+				step.Reveal, err = strconv.ParseFloat(strings.TrimSpace(value), 64)
+			case "index":
+				step.Index, err = strconv.Atoi(strings.TrimSpace(value))
+			default:
+				return nil, fmt.Errorf("%s: unknown condition %s", step.Type, key)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid %s: %w", step.Type, key, err)
+			}
+		}
+		ladder = append(ladder, step)
+	}
+	return ladder, nil
+}
+
+// Usage records the clues given for a single item.
+type Usage struct {
+	// Requests is the number of times each player has asked for a clue.
+	Requests map[string]int
+	// Steps is the number of steps of the ladder given so far.
+	Steps int
+}
+
+// Record increments the player's request count and returns the new count.
+func (u *Usage) Record(player string) int {
+	if u.Requests == nil {
+		u.Requests = map[string]int{}
+	}
+	u.Requests[player]++
+	return u.Requests[player]
+}
+
+// Given records the step of the ladder that was given.
+func (u *Usage) Given(step int) {
+	u.Steps = max(u.Steps, step+1)
+}
+
+// Total is the number of clue requests by all players.
+func (u *Usage) Total() int {
+	total := 0
+	for _, v := range u.Requests {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,55 @@
+package clues
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLadder_Next(t *testing.T) {
+	ladder, err := Parse("first-letter:unsolved=5;initials:after=12h;pattern:requests=2,reveal=0.5;text:index=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name         string
+		progress     Progress
+		custom       []string
+		expectedStep int
+		expectedText string
+	}{
+		{name: "locked", progress: Progress{Unsolved: 10, Requests: 1}, expectedStep: -1},
+		{name: "first step", progress: Progress{Unsolved: 5, Requests: 1}, expectedStep: 0, expectedText: "starts with: T"},
+		{name: "time unlocks second step", progress: Progress{Elapsed: time.Hour * 13, Unsolved: 5, Requests: 1}, expectedStep: 1, expectedText: "initials: TT"},
+		{name: "requests unlock pattern", progress: Progress{Elapsed: time.Hour * 13, Unsolved: 5, Requests: 2}, expectedStep: 2, expectedText: "pattern: `Th_ Th_n_`"},
+		{name: "custom text", progress: Progress{Elapsed: time.Hour * 13, Unsolved: 5, Requests: 2}, custom: []string{"Antarctica"}, expectedStep: 3, expectedText: "hint: Antarctica"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, text := ladder.Next(tt.progress, "The Thing", tt.custom)
+			if step != tt.expectedStep || text != tt.expectedText {
+				t.Errorf("expected %d %q got %d %q", tt.expectedStep, tt.expectedText, step, text)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, spec := range []string{"colour", "initials:after", "initials:after=soon", "initials:colour=red"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("%s: expected error", spec)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	usage := &Usage{}
+	usage.Record("alice")
+	if usage.Record("alice") != 2 || usage.Record("bob") != 1 || usage.Total() != 3 {
+		t.Fatalf("unexpected usage: %+v", usage)
+	}
+	usage.Given(1)
+	usage.Given(0)
+	if usage.Steps != 2 {
+		t.Fatalf("expected 2 steps got %d", usage.Steps)
+	}
+}
@@ -0,0 +1,433 @@
+package crossgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/warmans/go-crossword/v2"
+)
+
+var spaces = regexp.MustCompile(`\s+`)
+var nonAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9\s]+`)
+
+// ErrNotAllPlaced is returned when all words are required but no attempt placed them all.
+var ErrNotAllPlaced = errors.New("not all words were placed")
+
+// Weights used to combine the layout qualities into a single score. Words placed always
+// dominates so a layout missing a word is never preferred to one with all words.
+const (
+	placedWeight   = 1000.0
+	crossingWeight = 10.0
+	densityWeight  = 100.0
+	areaWeight     = 0.1
+)
+
+type Options struct {
+	GridSize int
+	// Attempts is the number of seeded layouts to try.
+	Attempts int
+	// Workers is the number of attempts to run in parallel. Defaults to the number of CPUs.
+	Workers int
+	// Seed of the first attempt. Each attempt uses the next seed. If zero a random seed is used.
+	Seed uint64
+	// RequireAll returns ErrNotAllPlaced if no layout contained all the words. Duplicate words are never placed.
+	RequireAll bool
+	// TimeBudget stops trying new attempts after the duration. Zero means no limit.
+	TimeBudget time.Duration
+}
+
+// Score is the quality of a layout.
+type Score struct {
+	Placed    int
+	Crossings int
+	// Density is the proportion of filled cells within the bounding box.
+	Density float64
+	Width   int
+	Height  int
+}
+
+func (s Score) Total() float64 {
+	return float64(s.Placed)*placedWeight +
+		float64(s.Crossings)*crossingWeight +
+		s.Density*densityWeight -
+		float64(s.Width*s.Height)*areaWeight
+}
+
+func (s Score) String() string {
+	return fmt.Sprintf(
+		"placed: %d, crossings: %d, density: %.2f, size: %dx%d, total: %.1f",
+		s.Placed,
+		s.Crossings,
+		s.Density,
+		s.Width,
+		s.Height,
+		s.Total(),
+	)
+}
+
+type Result struct {
+	Crossword *crossword.Crossword
+	// Seed can be used to reproduce the layout.
+	Seed  uint64
+	Score Score
+	// Attempts is the number of attempts that completed.
+	Attempts int
+}
+
+// Generate tries many seeded layouts in parallel and returns the best one. If RequireAll is set and no
+// layout placed all words the best result is still returned along with ErrNotAllPlaced. Words that are the same
+// once normalised are only placed once, the duplicates are returned by Unplaced.
+func Generate(words []crossword.Word, opts Options) (*Result, error) {
+	if opts.GridSize <= 0 {
+		return nil, fmt.Errorf("grid size must be greater than zero")
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("no words given")
+	}
+	prepared, _ := uniqueWords(prepareWords(words))
+	for _, v := range prepared {
+		if len(v.Word) > opts.GridSize {
+			return nil, fmt.Errorf("word is longer than grid size (%d): %s", opts.GridSize, v.Word)
+		}
+	}
+
+	attempts := max(1, opts.Attempts)
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	baseSeed := opts.Seed
+	if baseSeed == 0 {
+		// keep random seeds small enough to be passed back in as a flag.
+		baseSeed = uint64(rand.Int64N(math.MaxInt32))
+	}
+
+	ctx := context.Background()
+	if opts.TimeBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.TimeBudget)
+		defer cancel()
+	}
+
+	seeds := make(chan uint64)
+	go func() {
+		defer close(seeds)
+		// always make at least one attempt, even if the time budget is tiny.
+		seeds <- baseSeed
+		for k := 1; k < attempts; k++ {
+			select {
+			case <-ctx.Done():
+				return
+			case seeds <- baseSeed + uint64(k):
+			}
+		}
+	}()
+
+	var best *Result
+	var completed int
+	var resultLock sync.Mutex
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seed := range seeds {
+				cw := GenerateSeed(opts.GridSize, prepared, seed)
+				score := ScoreLayout(cw)
+
+				resultLock.Lock()
+				completed++
+				if best == nil || isBetter(score, seed, best.Score, best.Seed) {
+					best = &Result{Crossword: cw, Seed: seed, Score: score}
+				}
+				resultLock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	best.Attempts = completed
+	if opts.RequireAll && best.Score.Placed < len(words) {
+		return best, ErrNotAllPlaced
+	}
+	return best, nil
+}
+
+// isBetter compares scores. Equal scores are broken by the seed so the result doesn't depend on which
+// worker finished first.
+func isBetter(score Score, seed uint64, bestScore Score, bestSeed uint64) bool {
+	if score.Total() != bestScore.Total() {
+		return score.Total() > bestScore.Total()
+	}
+	return seed < bestSeed
+}
+
+// GenerateSeed creates a single layout. The same words and seed will always give the same layout.
+// Words must already have been prepared.
+func GenerateSeed(gridSize int, words []crossword.Word, seed uint64) *crossword.Crossword {
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	order := slices.Clone(words)
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+	// favour long words first, they are harder to fit in later.
+	slices.SortStableFunc(order, func(a, b crossword.Word) int {
+		return lengthBucket(b) - lengthBucket(a)
+	})
+
+	l := newLayout(gridSize)
+	l.place(order[0], (gridSize-len(order[0].Word))/2, gridSize/2, false)
+
+	remaining := order[1:]
+	for {
+		var unplaced []crossword.Word
+		for _, w := range remaining {
+			if !l.placeBest(w, rng) {
+				unplaced = append(unplaced, w)
+			}
+		}
+		// keep going while words that didn't fit earlier can now be placed.
+		if len(unplaced) == 0 || len(unplaced) == len(remaining) {
+			break
+		}
+		remaining = unplaced
+	}
+	return l.crossword()
+}
+
+// lengthBucket groups words by length so the shuffle still has an effect on words of a similar length.
+func lengthBucket(w crossword.Word) int {
+	return len(w.Word) / 3
+}
+
+// ScoreLayout measures the quality of a layout.
+func ScoreLayout(cw *crossword.Crossword) Score {
+	score := Score{Placed: len(cw.Words)}
+	minX, minY, maxX, maxY := len(cw.Grid), len(cw.Grid), -1, -1
+	filled := 0
+	for y, row := range cw.Grid {
+		for x, cell := range row {
+			if cell.Empty() {
+				continue
+			}
+			filled++
+			minX, minY, maxX, maxY = min(minX, x), min(minY, y), max(maxX, x), max(maxY, y)
+			if len(cw.CellPlacements(x, y)) > 1 {
+				score.Crossings++
+			}
+		}
+	}
+	if filled == 0 {
+		return score
+	}
+	score.Width = maxX - minX + 1
+	score.Height = maxY - minY + 1
+	score.Density = float64(filled) / float64(score.Width*score.Height)
+	return score
+}
+
+// Unplaced returns the words that are missing from the layout, including any duplicate words.
+func Unplaced(words []crossword.Word, cw *crossword.Crossword) []crossword.Word {
+	unique, unplaced := uniqueWords(prepareWords(words))
+	for _, w := range unique {
+		if !slices.ContainsFunc(cw.Words, func(pl crossword.Placement) bool { return pl.Word.Word == w.Word }) {
+			unplaced = append(unplaced, w)
+		}
+	}
+	return unplaced
+}
+
+// uniqueWords splits prepared words into the first occurrence of each word and any later duplicates.
+func uniqueWords(words []crossword.Word) ([]crossword.Word, []crossword.Word) {
+	var unique, duplicates []crossword.Word
+	seen := map[string]struct{}{}
+	for _, w := range words {
+		if _, ok := seen[w.Word]; ok {
+			duplicates = append(duplicates, w)
+			continue
+		}
+		seen[w.Word] = struct{}{}
+		unique = append(unique, w)
+	}
+	return unique, duplicates
+}
+
+// prepareWords normalises the words in the same way as crossword.Generate.
+func prepareWords(words []crossword.Word) []crossword.Word {
+	prepared := make([]crossword.Word, len(words))
+	for k, w := range words {
+		// already prepared
+		if len(w.LettersCounts) > 0 && !strings.ContainsFunc(w.Word, func(r rune) bool { return (r < 'A' || r > 'Z') && (r < '0' || r > '9') }) {
+			prepared[k] = w
+			continue
+		}
+		w.Word = strings.TrimSpace(spaces.ReplaceAllString(nonAlphanumeric.ReplaceAllString(w.Word, ""), " "))
+		w.LettersCounts = nil
+		for _, part := range strings.Split(w.Word, " ") {
+			w.LettersCounts = append(w.LettersCounts, len(part))
+		}
+		w.Word = strings.ReplaceAll(strings.ToUpper(w.Word), " ", "")
+		prepared[k] = w
+	}
+	return prepared
+}
+
+type direction struct {
+	across bool
+	down   bool
+}
+
+type layout struct {
+	size       int
+	grid       crossword.Grid
+	directions [][]direction
+	placements []crossword.Placement
+}
+
+func newLayout(size int) *layout {
+	directions := make([][]direction, size)
+	for y := range size {
+		directions[y] = make([]direction, size)
+	}
+	return &layout{size: size, grid: crossword.NewGrid(size), directions: directions}
+}
+
+func (l *layout) empty(x, y int) bool {
+	if x < 0 || y < 0 || x >= l.size || y >= l.size {
+		return true
+	}
+	return l.grid[y][x].Empty()
+}
+
+// crossings returns the number of existing letters the word would cross or -1 if it cannot be placed.
+func (l *layout) crossings(w crossword.Word, x, y int, vertical bool) int {
+	dx, dy := 1, 0
+	if vertical {
+		dx, dy = 0, 1
+	}
+	endX, endY := x+dx*(len(w.Word)-1), y+dy*(len(w.Word)-1)
+	if x < 0 || y < 0 || endX >= l.size || endY >= l.size {
+		return -1
+	}
+	// the cells before and after the word must be empty
+	if !l.empty(x-dx, y-dy) || !l.empty(endX+dx, endY+dy) {
+		return -1
+	}
+	crossings := 0
+	for i := range len(w.Word) {
+		cx, cy := x+dx*i, y+dy*i
+		cell := l.grid[cy][cx]
+		if !cell.Empty() {
+			dir := l.directions[cy][cx]
+			if cell.Char != rune(w.Word[i]) || (vertical && dir.down) || (!vertical && dir.across) {
+				return -1
+			}
+			crossings++
+			continue
+		}
+		// empty cells must not touch parallel words
+		if !l.empty(cx+dy, cy+dx) || !l.empty(cx-dy, cy-dx) {
+			return -1
+		}
+	}
+	if crossings == len(w.Word) {
+		return -1
+	}
+	return crossings
+}
+
+// placeBest places the word at the position with the most crossings. Ties are broken randomly.
+func (l *layout) placeBest(w crossword.Word, rng *rand.Rand) bool {
+	type candidate struct {
+		x, y     int
+		vertical bool
+	}
+	var best []candidate
+	bestCrossings := 0
+	for y := range l.size {
+		for x := range l.size {
+			cell := l.grid[y][x]
+			if cell.Empty() {
+				continue
+			}
+			for i := range len(w.Word) {
+				if rune(w.Word[i]) != cell.Char {
+					continue
+				}
+				for _, vertical := range []bool{false, true} {
+					cx, cy := x-i, y
+					if vertical {
+						cx, cy = x, y-i
+					}
+					c := l.crossings(w, cx, cy, vertical)
+					if c <= 0 || c < bestCrossings {
+						continue
+					}
+					if c > bestCrossings {
+						best = best[:0]
+						bestCrossings = c
+					}
+					if !slices.Contains(best, candidate{cx, cy, vertical}) {
+						best = append(best, candidate{cx, cy, vertical})
+					}
+				}
+			}
+		}
+	}
+	if len(best) == 0 {
+		return false
+	}
+	chosen := best[rng.IntN(len(best))]
+	l.place(w, chosen.x, chosen.y, chosen.vertical)
+	return true
+}
+
+func (l *layout) place(w crossword.Word, x, y int, vertical bool) {
+	for i := range len(w.Word) {
+		if vertical {
+			l.grid[y+i][x] = crossword.Cell{Char: rune(w.Word[i]), CharIdx: i}
+			l.directions[y+i][x].down = true
+		} else {
+			l.grid[y][x+i] = crossword.Cell{Char: rune(w.Word[i]), CharIdx: i}
+			l.directions[y][x+i].across = true
+		}
+	}
+	l.placements = append(l.placements, crossword.Placement{
+		ID:       len(l.placements) + 1,
+		Word:     w,
+		X:        x,
+		Y:        y,
+		Vertical: vertical,
+	})
+}
+
+// crossword moves the layout to the top left of the grid.
+func (l *layout) crossword() *crossword.Crossword {
+	minX, minY := l.size, l.size
+	for _, pl := range l.placements {
+		minX, minY = min(minX, pl.X), min(minY, pl.Y)
+	}
+	grid := crossword.NewGrid(l.size)
+	for y := minY; y < l.size; y++ {
+		for x := minX; x < l.size; x++ {
+			grid[y-minY][x-minX] = l.grid[y][x]
+		}
+	}
+	placements := make([]crossword.Placement, len(l.placements))
+	for k, pl := range l.placements {
+		pl.X -= minX
+		pl.Y -= minY
+		placements[k] = pl
+	}
+	return &crossword.Crossword{Grid: grid, Words: placements}
+}
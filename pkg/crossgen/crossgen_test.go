@@ -0,0 +1,84 @@
+package crossgen
+
+import (
+	"testing"
+
+	"github.com/warmans/go-crossword/v2"
+)
+
+var testWords = []crossword.Word{
+	{Word: "the big lebowski"},
+	{Word: "fargo"},
+	{Word: "alien"},
+	{Word: "heat"},
+	{Word: "jaws"},
+	{Word: "the thing"},
+	{Word: "goodfellas"},
+	{Word: "seven"},
+}
+
+func TestGenerateSeed_Reproducible(t *testing.T) {
+	words := prepareWords(testWords)
+	first := crossword.RenderText(GenerateSeed(20, words, 42), crossword.WithAllSolved(true))
+	second := crossword.RenderText(GenerateSeed(20, words, 42), crossword.WithAllSolved(true))
+	if first != second {
+		t.Fatalf("expected the same layout for the same seed:\n%s\n%s", first, second)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	res, err := Generate(testWords, Options{GridSize: 20, Attempts: 50, Seed: 1, RequireAll: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Attempts != 50 {
+		t.Fatalf("expected 50 attempts got %d", res.Attempts)
+	}
+	if len(Unplaced(testWords, res.Crossword)) != 0 {
+		t.Fatal("expected all words to be placed")
+	}
+	// every placed word must be readable from the grid
+	for _, pl := range res.Crossword.Words {
+		for i := range len(pl.Word.Word) {
+			x, y := pl.X+i, pl.Y
+			if pl.Vertical {
+				x, y = pl.X, pl.Y+i
+			}
+			if got := res.Crossword.Grid[y][x].Char; got != rune(pl.Word.Word[i]) {
+				t.Fatalf("%s: expected %c at %d,%d got %c", pl.Word.Word, pl.Word.Word[i], x, y, got)
+			}
+		}
+	}
+	again, err := Generate(testWords, Options{GridSize: 20, Attempts: 1, Seed: res.Seed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Score != res.Score {
+		t.Fatalf("expected seed %d to reproduce the layout", res.Seed)
+	}
+}
+
+func TestGenerate_RequireAll(t *testing.T) {
+	_, err := Generate([]crossword.Word{{Word: "abc"}, {Word: "xyz"}}, Options{GridSize: 10, Attempts: 5, RequireAll: true})
+	if err != ErrNotAllPlaced {
+		t.Fatalf("expected ErrNotAllPlaced got %v", err)
+	}
+}
+
+func TestGenerate_Duplicates(t *testing.T) {
+	words := append([]crossword.Word{{Word: "Fargo!", Clue: "duplicate"}}, testWords...)
+	res, err := Generate(words, Options{GridSize: 20, Attempts: 50, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Crossword.Words) != len(testWords) {
+		t.Fatalf("expected %d words to be placed got %d", len(testWords), len(res.Crossword.Words))
+	}
+	unplaced := Unplaced(words, res.Crossword)
+	if len(unplaced) != 1 || unplaced[0].Word != "FARGO" || unplaced[0].Clue != "" {
+		t.Fatalf("expected the second fargo to be unplaced got %v", unplaced)
+	}
+	if _, err := Generate(words, Options{GridSize: 20, Attempts: 50, Seed: 1, RequireAll: true}); err != ErrNotAllPlaced {
+		t.Fatalf("expected ErrNotAllPlaced got %v", err)
+	}
+}
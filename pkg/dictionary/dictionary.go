@@ -1,6 +1,7 @@
 //go:generate sh -c "go run ../../script/dictionary/wordlist/generate.go nouns.json Nouns > nouns.gen.go"
 //go:generate sh -c "go run ../../script/dictionary/wordlist/generate.go objects.json Objects > objects.gen.go"
 //go:generate sh -c "go run ../../script/dictionary/songs/songs.go > songs.gen.go"
+//go:generate sh -c "go run ../../script/dictionary/songs/songs.go tracks > tracks.gen.go"
 package dictionary
 
 import "math/rand"
@@ -0,0 +1,666 @@
+package dictionary
+
+type Track struct {
+	Name   string
+	Artist string
+	Album  string
+}
+
+var Tracks = []Track{
+	{Name: "California Love - Original Version", Artist: "2Pac", Album: "Greatest Hits"},
+	{Name: "Picture Me Rollin' (ft. Danny Boy, CPO, Big Syke)", Artist: "2Pac", Album: "All Eyez On Me"},
+	{Name: "Syntax Error", Artist: "311", Album: "Mosaic"},
+	{Name: "Les Fleur", Artist: "4hero", Album: "Creating Patterns"},
+	{Name: "Pimpin', Pt. 2", Artist: "50 Cent, DJ Whoo Kid", Album: "Bullet Proof"},
+	{Name: "21 Questions", Artist: "50 Cent", Album: "Get Rich Or Die Tryin'"},
+	{Name: "In Da Club", Artist: "50 Cent", Album: "Get Rich Or Die Tryin'"},
+	{Name: "You Shook Me All Night Long", Artist: "AC/DC", Album: "Back In Black"},
+	{Name: "Metropolis", Artist: "Adam F", Album: "15 Years of Metalheadz"},
+	{Name: "Jaded", Artist: "Aerosmith", Album: "Just Push Play"},
+	{Name: "Red Vines", Artist: "Aimee Mann", Album: "Bachelor No. 2 (Or, The Last Remains of the Dodo)"},
+	{Name: "Wise Up", Artist: "Aimee Mann", Album: "Bachelor, No. 2 (Or, The Last Remains of the Dodo) [20th Anniversary Edition]"},
+	{Name: "Fortunes Faded (Karaoke Version) - Originally Performed By Red Hot Chili Peppers", Artist: "All Star Tribute Band", Album: "A Tribute to the Red Hot Chili Peppers (Karaoke Version) (Sing the Hits of the Red Hot Chili Peppers)"},
+	{Name: "Burning Up", Artist: "Alpinestars", Album: "White Noise"},
+	{Name: "Carbon Kid", Artist: "Alpinestars", Album: "White Noise"},
+	{Name: "Monsters of the Id (feat. Elvis Costello)", Artist: "Amy Allison", Album: "Sheffield Streets"},
+	{Name: "I Remember Learning How To Dive", Artist: "Animal Collective", Album: "Prospect Hummer"},
+	{Name: "Hope There's Someone", Artist: "Antony and the Johnsons", Album: "I Am A Bird Now"},
+	{Name: "Brighter Than Sunshine", Artist: "Aqualung", Album: "Strange & Beautiful"},
+	{Name: "Good Times Gonna Come", Artist: "Aqualung", Album: "Strange & Beautiful"},
+	{Name: "All downhill from here", Artist: "Art Vandeley", Album: "All downhill from here"},
+	{Name: "Burn Baby Burn", Artist: "Ash", Album: "Free All Angels"},
+	{Name: "There's a Star", Artist: "Ash", Album: "Free All Angels"},
+	{Name: "Sometimes People Suck", Artist: "Ashe", Album: "The Rabbit Hole"},
+	{Name: "El Salvador", Artist: "Athlete", Album: "Vehicles & Animals"},
+	{Name: "Westside", Artist: "Athlete", Album: "Vehicles & Animals"},
+	{Name: "You Got the Style", Artist: "Athlete", Album: "Vehicles & Animals"},
+	{Name: "Walk Out to Winter", Artist: "Aztec Camera", Album: "High Land, Hard Rain"},
+	{Name: "Back Together (Version 1)", Artist: "Babybird", Album: "There's Something Going On (Expanded)"},
+	{Name: "All Possibilities", Artist: "Badly Drawn Boy", Album: "Have You Fed The Fish"},
+	{Name: "Born Again", Artist: "Badly Drawn Boy", Album: "Have You Fed The Fish"},
+	{Name: "Donna and Blitzen", Artist: "Badly Drawn Boy", Album: "About A Boy Soundtrack"},
+	{Name: "Magic in the Air", Artist: "Badly Drawn Boy", Album: "The Hour of Bewilderbeast"},
+	{Name: "Once Around the Block", Artist: "Badly Drawn Boy", Album: "Once Around the Block"},
+	{Name: "Silent Sigh", Artist: "Badly Drawn Boy", Album: "About A Boy Soundtrack"},
+	{Name: "Something to Talk About", Artist: "Badly Drawn Boy", Album: "About A Boy Soundtrack"},
+	{Name: "You Were Right", Artist: "Badly Drawn Boy", Album: "Have You Fed The Fish"},
+	{Name: "Where's Your Head At", Artist: "Basement Jaxx", Album: "Rooty"},
+	{Name: "Ziggy Stardust", Artist: "Bauhaus", Album: "The Sky's Gone Out"},
+	{Name: "Feel It In The Air", Artist: "Beanie Sigel", Album: "The B.Coming"},
+	{Name: "Loser", Artist: "Beck", Album: "Mellow Gold"},
+	{Name: "Lost Cause", Artist: "Beck", Album: "Sea Change"},
+	{Name: "Legal Man", Artist: "Belle and Sebastian", Album: "Push Barman To Open Old Wounds, Vol. 2"},
+	{Name: "The Boy with the Arab Strap", Artist: "Belle and Sebastian", Album: "The Boy With The Arab Strap"},
+	{Name: "The Loneliness of a Middle Distance Runner", Artist: "Belle and Sebastian", Album: "Push Barman To Open Old Wounds, Vol. 2"},
+	{Name: "Landed", Artist: "Ben Folds", Album: "Songs For Silverman"},
+	{Name: "In Other Words", Artist: "Ben Kweller", Album: "Sha Sha"},
+	{Name: "Jingle Bells", Artist: "Benny Goodman", Album: "Benny Goodman and His Rhythm Makers, Vol. 2: Original 1935 Radio Transcriptions"},
+	{Name: "Beta Band", Artist: "Betagarri", Album: "Freaky Festa"},
+	{Name: "Tom The Model", Artist: "Beth Gibbons", Album: "Out Of Season"},
+	{Name: "Crazy In Love (feat. Jay-Z)", Artist: "Beyoncé", Album: "Dangerously In Love"},
+	{Name: "Who Is He (And What Is He to You)?", Artist: "Bill Withers", Album: "Still Bill"},
+	{Name: "The Price I Pay", Artist: "Billy Bragg", Album: "Workers Playtime"},
+	{Name: "The Saturday Boy", Artist: "Billy Bragg", Album: "Brewing up With"},
+	{Name: "Kelly's Heroes", Artist: "Black Grape", Album: "It's Great When You're Straight ... Yeah"},
+	{Name: "Love Burns", Artist: "Black Rebel Motorcycle Club", Album: "B.R.M.C."},
+	{Name: "Spread Your Love", Artist: "Black Rebel Motorcycle Club", Album: "B.R.M.C."},
+	{Name: "Stop", Artist: "Black Rebel Motorcycle Club", Album: "Take Them On, On Your Own"},
+	{Name: "We're All In Love", Artist: "Black Rebel Motorcycle Club", Album: "Take Them On, On Your Own"},
+	{Name: "Whatever Happened To My Rock 'N' Roll (Punk Song)", Artist: "Black Rebel Motorcycle Club", Album: "B.R.M.C."},
+	{Name: "Coffee & TV", Artist: "Blur", Album: "13"},
+	{Name: "Coffee and TV", Artist: "Blur", Album: "Músicas para Dias de Chuva"},
+	{Name: "Crazy Beat", Artist: "Blur", Album: "Think Tank (Special Edition)"},
+	{Name: "Girls & Boys", Artist: "Blur", Album: "Blur: The Best Of"},
+	{Name: "Girls and Boys", Artist: "Blur", Album: "Sport - Hits for Your Workout"},
+	{Name: "Out of Time", Artist: "Blur", Album: "Canciones para practicar inglés - Vol. 1"},
+	{Name: "Song 2 - 2012 Remaster", Artist: "Blur", Album: "Blur (Special Edition)"},
+	{Name: "The Universal - 2012 Remaster", Artist: "Blur", Album: "The Great Escape (Special Edition)"},
+	{Name: "All Along the Watchtower", Artist: "Bob Dylan", Album: "John Wesley Harding"},
+	{Name: "If You See Her, Say Hello", Artist: "Bob Dylan", Album: "Blood On The Tracks"},
+	{Name: "It's All Over Now, Baby Blue", Artist: "Bob Dylan", Album: "Bringing It All Back Home"},
+	{Name: "Just Like a Woman", Artist: "Bob Dylan", Album: "Blonde On Blonde"},
+	{Name: "Mississippi", Artist: "Bob Dylan", Album: "Love And Theft"},
+	{Name: "Positively 4th Street", Artist: "Bob Dylan", Album: "Bob Dylan's Greatest Hits"},
+	{Name: "You're Gonna Make Me Lonesome When You Go", Artist: "Bob Dylan", Album: "Blood On The Tracks"},
+	{Name: "Stir It Up - Jamaican Version", Artist: "Bob Marley & The Wailers", Album: "Catch A Fire"},
+	{Name: "Across 110th Street", Artist: "Bobby Womack", Album: "Midnight Mover: The Bobby Womack Story"},
+	{Name: "Athletes", Artist: "BounceBackMeek", Album: "West Side Story"},
+	{Name: "Atlantic City", Artist: "Bruce Springsteen", Album: "Nebraska"},
+	{Name: "Atlantic City - Live at the Point Theatre, Dublin, Ireland - November 2006", Artist: "Bruce Springsteen", Album: "Live In Dublin"},
+	{Name: "Brilliant Disguise", Artist: "Bruce Springsteen", Album: "Tunnel Of Love"},
+	{Name: "Darkness On the Edge of Town", Artist: "Bruce Springsteen", Album: "Darkness On the Edge of Town"},
+	{Name: "Hungry Heart", Artist: "Bruce Springsteen", Album: "The River"},
+	{Name: "Lonesome Day", Artist: "Bruce Springsteen", Album: "The Rising"},
+	{Name: "Nothing Man", Artist: "Bruce Springsteen", Album: "The Rising"},
+	{Name: "Santa Claus Is Coming To Town", Artist: "Bruce Springsteen", Album: "NOW That's What I Call Merry Christmas"},
+	{Name: "The Rising", Artist: "Bruce Springsteen", Album: "The Rising"},
+	{Name: "The River", Artist: "Bruce Springsteen", Album: "The River"},
+	{Name: "Thunder Road", Artist: "Bruce Springsteen", Album: "Born To Run"},
+	{Name: "Natalie", Artist: "Bruno Mars", Album: "Unorthodox Jukebox"},
+	{Name: "Summer Of '69", Artist: "Bryan Adams", Album: "Reckless (30th Anniversary / Deluxe Edition)"},
+	{Name: "Slave To Love", Artist: "Bryan Ferry", Album: "Boys And Girls"},
+	{Name: "Ugly", Artist: "Bubba Sparxxx", Album: "Dark Days, Bright Nights"},
+	{Name: "Harmony In My Head - 2001 Remastered Version", Artist: "Buzzcocks", Album: "Singles Going Steady"},
+	{Name: "Short Skirt / Long Jacket", Artist: "CAKE", Album: "Comfort Eagle"},
+	{Name: "DREAMWALK", Artist: "CELEST!AL V!BRAT!ON", Album: "DREAM WANDERER"},
+	{Name: "Stress Relief Calm Oasis", Artist: "Calm Singing Birds Zone", Album: "Relaxing Music: Morning Birds Songs, Peaceful Afternoon in the Forest, Ambient Nature Sounds to Reduce Stress and Well Being"},
+	{Name: "(They Long To Be) Close To You", Artist: "Carpenters", Album: "Close To You"},
+	{Name: "I Stand All Amazed (arr. R. Staheli for choir)", Artist: "Charles H. Gabriel", Album: "We Sing of Christ: The Songs of Zion"},
+	{Name: "Walking With Thee", Artist: "Clinic", Album: "Walking With Thee"},
+	{Name: "Clocks", Artist: "Coldplay", Album: "A Rush of Blood to the Head"},
+	{Name: "Don't Panic", Artist: "Coldplay", Album: "Anti-Valentine's Vibes"},
+	{Name: "God Put a Smile upon Your Face", Artist: "Coldplay", Album: "A Rush of Blood to the Head"},
+	{Name: "In My Place", Artist: "Coldplay", Album: "A Rush of Blood to the Head"},
+	{Name: "One I Love", Artist: "Coldplay", Album: "In My Place"},
+	{Name: "Speed of Sound", Artist: "Coldplay", Album: "X&Y"},
+	{Name: "Talk", Artist: "Coldplay", Album: "X&Y"},
+	{Name: "The Scientist", Artist: "Coldplay", Album: "A Rush of Blood to the Head"},
+	{Name: "Warning Sign", Artist: "Coldplay", Album: "A Rush of Blood to the Head"},
+	{Name: "Yellow", Artist: "Coldplay", Album: "Parachutes"},
+	{Name: "Lessons Learned from Rocky I to Rocky III", Artist: "Cornershop", Album: "Handcream for a Generation"},
+	{Name: "I Feel Free", Artist: "Cream", Album: "Fresh Cream"},
+	{Name: "Hot in Here", Artist: "Crib", Album: "The Very Best of Nelly"},
+	{Name: "I will always be with you.", Artist: "Cure Mind", Album: "The piano that you hear in love"},
+	{Name: "(Rock) Superstar (feat. Chino Moreno & Everlast)", Artist: "Cypress Hill", Album: "Skull & Bones"},
+	{Name: "Mongoloid", Artist: "DEVO", Album: "Q: Are We Not Men? A: We Are Devo!"},
+	{Name: "Aerodynamic", Artist: "Daft Punk", Album: "Discovery"},
+	{Name: "A Cappella", Artist: "Daniel Caesar", Album: "Pilgrim's Paradise"},
+	{Name: "Ashes to Ashes - 2017 Remaster", Artist: "David Bowie", Album: "Scary Monsters (And Super Creeps) [2017 Remaster]"},
+	{Name: "Be My Wife - 2017 Remaster", Artist: "David Bowie", Album: "Low (2017 Remaster)"},
+	{Name: "Can You Hear Me - 2016 Remaster", Artist: "David Bowie", Album: "Young Americans (2016 Remaster)"},
+	{Name: "Drive-In Saturday - 2013 Remaster", Artist: "David Bowie", Album: "Aladdin Sane (2013 Remaster)"},
+	{Name: "Fashion - 2017 Remaster", Artist: "David Bowie", Album: "Scary Monsters (And Super Creeps) [2017 Remaster]"},
+	{Name: "Lady Grinning Soul - 2013 Remaster", Artist: "David Bowie", Album: "Aladdin Sane (2013 Remaster)"},
+	{Name: "Lady Stardust - 2012 Remaster", Artist: "David Bowie", Album: "The Rise and Fall of Ziggy Stardust and the Spiders from Mars (2012 Remaster)"},
+	{Name: "Letter to Hermione - 2015 Remaster", Artist: "David Bowie", Album: "David Bowie (aka Space Oddity) [2015 Remaster]"},
+	{Name: "Life on Mars? - 2015 Remaster", Artist: "David Bowie", Album: "Hunky Dory (2015 Remaster)"},
+	{Name: "Sorrow - 2015 Remaster", Artist: "David Bowie", Album: "Pinups (2015 Remaster)"},
+	{Name: "Sweet Thing - 2016 Remaster", Artist: "David Bowie", Album: "Diamond Dogs (2016 Remaster)"},
+	{Name: "Watch That Man - 2013 Remaster", Artist: "David Bowie", Album: "Aladdin Sane (2013 Remaster)"},
+	{Name: "Ziggy Stardust - 2012 Remaster", Artist: "David Bowie", Album: "The Rise and Fall of Ziggy Stardust and the Spiders from Mars (2012 Remaster)"},
+	{Name: "This Year's Love", Artist: "David Gray", Album: "White Ladder"},
+	{Name: "(I Wish I Had A) Wooden Heart - New Mix", Artist: "David Holmes presents The Free Association", Album: "David Holmes presents The Free Association"},
+	{Name: "A Roller Skating Jam Named \"Saturdays\"", Artist: "De La Soul", Album: "De La Soul is Dead"},
+	{Name: "Watch Out", Artist: "De La Soul", Album: "AOI: Bionix"},
+	{Name: "With Me / Ghost Weed Skit 03", Artist: "De La Soul", Album: "Art Official Intelligence: Mosaic Thump"},
+	{Name: "Girls", Artist: "Death In Vegas", Album: "Scorpio Rising"},
+	{Name: "Scorpio Rising", Artist: "Death In Vegas", Album: "Scorpio Rising"},
+	{Name: "I Feel Loved - 2007 Remaster", Artist: "Depeche Mode", Album: "Exciter (2007 Remaster)"},
+	{Name: "Same Song - Edit Version", Artist: "Digital Underground", Album: "Hi-Five: Digital Underground"},
+	{Name: "Worst Comes To Worst - Edited", Artist: "Dilated Peoples", Album: "Expansion Team"},
+	{Name: "Start Choppin'", Artist: "Dinosaur Jr.", Album: "Where You Been"},
+	{Name: "Bonkers", Artist: "Dizzee Rascal", Album: "Tongue N' Cheek (Dirtee Deluxe Edition)"},
+	{Name: "Tulsa Time", Artist: "Don Williams", Album: "Expressions"},
+	{Name: "Ride Wit Me (Radio Version) [In the Style of Nelly feat. City Spud] {Performance Track with Demonstration Vocals}", Artist: "Done Again", Album: "Ride Wit Me (Radio Version) [In the Style of Nelly feat. City Spud] {Performance Track with Demonstration Vocals}"},
+	{Name: "Caught By The River", Artist: "Doves", Album: "The Last Broadcast"},
+	{Name: "There Goes The Fear", Artist: "Doves", Album: "The Last Broadcast"},
+	{Name: "Suga Boom Boom", Artist: "Down3r", Album: "Down3r"},
+	{Name: "Bad Intentions", Artist: "Dr. Dre", Album: "The Wash"},
+	{Name: "El President", Artist: "Drugstore", Album: "White Magic For Lovers"},
+	{Name: "20 Wave Caps (feat. Domo Genesis)", Artist: "Earl Sweatshirt", Album: "Doris"},
+	{Name: "The Killing Moon", Artist: "Echo & the Bunnymen", Album: "Ocean Rain"},
+	{Name: "The Birds Will Sing for Us", Artist: "Ed Harcourt", Album: "From Every Sphere"},
+	{Name: "Fresh Feeling", Artist: "Eels", Album: "Souljacker"},
+	{Name: "Mr. E's Beautiful Blues", Artist: "Eels", Album: "Daisies Of The Galaxy"},
+	{Name: "Asleep In The Back", Artist: "Elbow", Album: "Asleep In The Back"},
+	{Name: "Fallen Angel", Artist: "Elbow", Album: "Cast Of Thousands"},
+	{Name: "Red", Artist: "Elbow", Album: "Asleep In The Back"},
+	{Name: "Danger! High Voltage - Soulchild Radio Mix", Artist: "Electric Six", Album: "Fire"},
+	{Name: "Getting Away with It - 2013 Remaster", Artist: "Electronic", Album: "Electronic (Special Edition)"},
+	{Name: "Son Of Sam", Artist: "Elliott Smith", Album: "Figure 8"},
+	{Name: "Saturday Night's Alright (For Fighting)", Artist: "Elton John", Album: "To Be Continued..."},
+	{Name: "Tiny Dancer", Artist: "Elton John", Album: "Madman Across The Water"},
+	{Name: "(The Angels Wanna Wear My) Red Shoes", Artist: "Elvis Costello", Album: "My Aim Is True"},
+	{Name: "Alison", Artist: "Elvis Costello", Album: "My Aim Is True"},
+	{Name: "I Still Have That Other Girl", Artist: "Elvis Costello", Album: "Painted From Memory"},
+	{Name: "A Glorious Day", Artist: "Embrace", Album: "Out Of Nothing"},
+	{Name: "Gravity", Artist: "Embrace", Album: "Out Of Nothing"},
+	{Name: "Make It Last", Artist: "Embrace", Album: "If You've Never Been"},
+	{Name: "Business", Artist: "Eminem", Album: "The Eminem Show"},
+	{Name: "Lose Yourself", Artist: "Eminem", Album: "Curtain Call: The Hits (Deluxe Edition)"},
+	{Name: "Sing For The Moment", Artist: "Eminem", Album: "The Eminem Show"},
+	{Name: "The Real Slim Shady", Artist: "Eminem", Album: "The Marshall Mathers LP"},
+	{Name: "Without Me", Artist: "Eminem", Album: "The Eminem Show"},
+	{Name: "React (feat. Redman)", Artist: "Erick Sermon", Album: "React"},
+	{Name: "All My Life", Artist: "Evan Dando", Album: "Baby I'm Bored"},
+	{Name: "Looking for Space", Artist: "Evan Dando", Album: "The Music Is You: A Tribute to John Denver"},
+	{Name: "Stop My Head", Artist: "Evan Dando", Album: "Baby I'm Bored"},
+	{Name: "Bring Me To Life", Artist: "Evanescence", Album: "Fallen"},
+	{Name: "Satisfaction", Artist: "Eve", Album: "Eve-Olution"},
+	{Name: "I'm Easy (Cooler Version)", Artist: "Faith No More", Album: "Easy Like Sunday Morning"},
+	{Name: "What's Luv? (feat. Ja-Rule & Ashanti)", Artist: "Fat Joe", Album: "Jealous Ones Still Envy (J.O.S.E)"},
+	{Name: "Praise You - Radio Edit", Artist: "Fatboy Slim", Album: "The Greatest Hits: Why Try Harder"},
+	{Name: "Come Back Around", Artist: "Feeder", Album: "Comfort in Sound"},
+	{Name: "Forget About Tomorrow", Artist: "Feeder", Album: "Comfort in Sound"},
+	{Name: "Just a Day (Alan Moulder Mix)", Artist: "Feeder", Album: "Just a Day"},
+	{Name: "Just the Way I'm Feeling", Artist: "Feeder", Album: "Comfort in Sound"},
+	{Name: "Pushing the Senses", Artist: "Feeder", Album: "Pushing the Senses"},
+	{Name: "Eye of the Tiger (snippet)", Artist: "Fharoawh", Album: "Keep Knock 'N'"},
+	{Name: "No Choice", Artist: "Fly By Midnight", Album: "Silver Crane"},
+	{Name: "All My Life", Artist: "Foo Fighters", Album: "One By One (Expanded Edition)"},
+	{Name: "Best of You", Artist: "Foo Fighters", Album: "In Your Honor"},
+	{Name: "Learn to Fly", Artist: "Foo Fighters", Album: "There Is Nothing Left To Lose"},
+	{Name: "Let Me Try Again (Laisse Moi le Temps)", Artist: "Frank Sinatra", Album: "Ol' Blue Eyes Is Back"},
+	{Name: "Band Of Gold (Single Mix)", Artist: "Freda Payne", Album: "Band Of Gold"},
+	{Name: "Beauty & Essex (feat. Daniel Caesar & Unknown Mortal Orchestra)", Artist: "Free Nationals", Album: "Free Nationals"},
+	{Name: "My Brother Jake", Artist: "Free", Album: "Highway (Remastered with Bonus Tracks)"},
+	{Name: "Bizarre Love Triangle - 2014 Remaster", Artist: "Frente!", Album: "Marvin The Album - 21st Anniversary Edition"},
+	{Name: "Rot", Artist: "Fujiya & Miyagi", Album: "Electro Karaoke in the Negative Style"},
+	{Name: "Scooby Snacks", Artist: "Fun Lovin' Criminals", Album: "Come Find Yourself"},
+	{Name: "Stunt 101", Artist: "G-Unit", Album: "Beg For Mercy"},
+	{Name: "Cherry Lips (Go Baby Go!) - 2021 Remaster", Artist: "Garbage", Album: "beautiful garbage (20th Anniversary / Deluxe)"},
+	{Name: "Mad World", Artist: "Gary Jules", Album: "Trading Snakeoil for Wolftickets"},
+	{Name: "Cars", Artist: "Gary Numan", Album: "The Pleasure Principle"},
+	{Name: "Truth, Rest Your Head", Artist: "Gene", Album: "Olympian"},
+	{Name: "Let You Down", Artist: "Goldrush", Album: "Let You Down"},
+	{Name: "Shot Shot", Artist: "Gomez", Album: "In Our Gun"},
+	{Name: "Rock the House", Artist: "Gorillaz", Album: "Gorillaz"},
+	{Name: "1-800 Suicide", Artist: "Gravediggaz", Album: "6 Feet Deep"},
+	{Name: "Good Riddance (Time of Your Life)", Artist: "Green Day", Album: "Nimrod"},
+	{Name: "Suntoucher", Artist: "Groove Armada", Album: "Goodbye Country (Hello Nightclub)"},
+	{Name: "Superstylin'", Artist: "Groove Armada", Album: "Goodbye Country (Hello Nightclub)"},
+	{Name: "Sweet Child O' Mine", Artist: "Guns N' Roses", Album: "Appetite For Destruction"},
+	{Name: "Teenage Sensation", Artist: "GusGus", Album: "This Is Normal"},
+	{Name: "Kinky Afro", Artist: "Happy Mondays", Album: "Greatest Hits"},
+	{Name: "Step On - 2007 Remaster", Artist: "Happy Mondays", Album: "Pills 'N' Thrills And Bellyaches (Collector's Edition)"},
+	{Name: "Bandages", Artist: "Hot Hot Heat", Album: "Make Up The Breakdown"},
+	{Name: "REM", Artist: "Humbe", Album: "REM"},
+	{Name: "I'll Find You", Artist: "Hundred Reasons", Album: "Ideas Above Our Station"},
+	{Name: "If I Could", Artist: "Hundred Reasons", Album: "Ideas Above Our Station"},
+	{Name: "To You - Remastered", Artist: "I Am Kloot", Album: "Natural History (Deluxe Version Remastered)"},
+	{Name: "Dolphins Were Monkeys - New Version", Artist: "Ian Brown", Album: "The Greatest"},
+	{Name: "Hit Me With Your Rhythm Stick", Artist: "Ian Dury", Album: "Sex & Drugs & Rock & Roll"},
+	{Name: "It Was A Good Day", Artist: "Ice Cube", Album: "The Predator"},
+	{Name: "I'm Bored", Artist: "Iggy Pop", Album: "Arista Heritage Series: Iggy Pop"},
+	{Name: "Danger! High Voltage (In The Style Of Electric Six) - Karaoke Version", Artist: "Immense Media", Album: "Immense Media Presents - The Immense Rock Karaoke Collection, Vol. 10"},
+	{Name: "Are You In?", Artist: "Incubus", Album: "Morning View"},
+	{Name: "Just Because", Artist: "Jane's Addiction", Album: "Strays"},
+	{Name: "Brooklyn", Artist: "Jesse Malin", Album: "The Fine Art of Self-Destruction"},
+	{Name: "Are You Gonna Be My Girl", Artist: "Jet", Album: "Get Born"},
+	{Name: "Rollover D.J.", Artist: "Jet", Album: "Get Born"},
+	{Name: "Tager Med DJen Over Skyen (Prod. Rollie)", Artist: "Jetzzz", Album: "Kærlighed, Harmoni & Klaverspil"},
+	{Name: "All Along the Watchtower", Artist: "Jimi Hendrix", Album: "Electric Ladyland"},
+	{Name: "Galveston", Artist: "Jimmy Webb", Album: "Ten Easy Pieces"},
+	{Name: "If These Walls Could Speak", Artist: "Jimmy Webb", Album: "Ten Easy Pieces"},
+	{Name: "It's Different For Girls", Artist: "Joe Jackson", Album: "I'm The Man"},
+	{Name: "Happy Xmas (War Is Over) - Remastered 2010", Artist: "John Lennon", Album: "Signature Box"},
+	{Name: "May You Never", Artist: "John Martyn", Album: "Solid Air"},
+	{Name: "Desperado", Artist: "Johnny Cash", Album: "American IV: The Man Comes Around"},
+	{Name: "One Piece at a Time", Artist: "Johnny Cash", Album: "One Piece At A Time"},
+	{Name: "Blue Motel Room", Artist: "Joni Mitchell", Album: "Hejira"},
+	{Name: "River", Artist: "Joni Mitchell", Album: "Blue"},
+	{Name: "Love Will Tear Us Apart - 2020 Remaster", Artist: "Joy Division", Album: "Love Will Tear Us Apart"},
+	{Name: "breadwinner", Artist: "Kacey Musgraves", Album: "star-crossed"},
+	{Name: "King Of The Mountain - 2018 Remaster", Artist: "Kate Bush", Album: "Aerial (2018 Remaster)"},
+	{Name: "Somewhere Only We Know", Artist: "Keane", Album: "Hopes And Fears"},
+	{Name: "Milkshake - Radio Mix", Artist: "Kelis", Album: "Tasty"},
+	{Name: "Ruby Don't Take Your Love To Town", Artist: "Kenny Rogers", Album: "Ten Years Of Gold"},
+	{Name: "Pretty Please", Artist: "Kevin Tihista's Red Terror", Album: "Don't Breathe A Word"},
+	{Name: "Smoulder", Artist: "King Adora", Album: "Vibrate You"},
+	{Name: "Broke Advice", Artist: "King Single", Album: "The Singles, Vol. 1"},
+	{Name: "Misread", Artist: "Kings of Convenience", Album: "Riot On An Empty Street"},
+	{Name: "California Waiting", Artist: "Kings of Leon", Album: "Youth And Young Manhood"},
+	{Name: "Molly's Chambers", Artist: "Kings of Leon", Album: "Youth And Young Manhood"},
+	{Name: "Red Morning Light", Artist: "Kings of Leon", Album: "Youth And Young Manhood"},
+	{Name: "Sex on Fire", Artist: "Kings of Leon", Album: "Only By The Night"},
+	{Name: "A New England", Artist: "Kirsty MacColl", Album: "The Stiff Singles Collection"},
+	{Name: "Backstreet Girl - Bonus Track", Artist: "Lambchop", Album: "Is a Woman (Deluxe Reissue)"},
+	{Name: "Up With People", Artist: "Lambchop", Album: "Nixon"},
+	{Name: "Rock and Roll - Remaster", Artist: "Led Zeppelin", Album: "Led Zeppelin IV (Deluxe Edition)"},
+	{Name: "The Rain Song - Remaster", Artist: "Led Zeppelin", Album: "Houses of the Holy (Remaster)"},
+	{Name: "Whole Lotta Love - 1990 Remaster", Artist: "Led Zeppelin", Album: "Led Zeppelin II (1994 Remaster)"},
+	{Name: "The Jump Off", Artist: "Lil' Kim", Album: "La Bella Mafia"},
+	{Name: "Behind Blue Eyes", Artist: "Limp Bizkit", Album: "Results May Vary"},
+	{Name: "In the End", Artist: "Linkin Park", Album: "Hybrid Theory (Bonus Edition)"},
+	{Name: "Play Some Rock", Artist: "Liquido", Album: "At the Rocks"},
+	{Name: "Are You Ready To Be Heartbroken? - Remastered", Artist: "Lloyd Cole and the Commotions", Album: "Rattlesnakes (Remastered)"},
+	{Name: "Chelsea Hotel #2", Artist: "Lloyd Cole", Album: "Cleaning out the Ashtrays"},
+	{Name: "Impossible Girl", Artist: "Lloyd Cole", Album: "The Negatives"},
+	{Name: "Like Lovers Do", Artist: "Lloyd Cole", Album: "Love Story"},
+	{Name: "She's A Girl And I'm A Man", Artist: "Lloyd Cole", Album: "Don't Get Weird On Me, Babe"},
+	{Name: "Further", Artist: "Longview", Album: "Volume Up - Rock"},
+	{Name: "Satellite of Love", Artist: "Lou Reed", Album: "Transformer"},
+	{Name: "Vicious", Artist: "Lou Reed", Album: "Transformer"},
+	{Name: "Sweet Home Alabama", Artist: "Lynyrd Skynyrd", Album: "Second Helping (Expanded Edition)"},
+	{Name: "Variations On A Theme By Beethoven, Op. 35: Allegro", Artist: "Madeleine Forte", Album: "A Celebration of Duo-Piano Music: Spanish, French & Russian Programs"},
+	{Name: "Hung Up", Artist: "Madonna", Album: "Confessions on a Dance Floor"},
+	{Name: "Sunset Coming On", Artist: "Malian Musicians", Album: "Mali Music"},
+	{Name: "There by the Grace of God", Artist: "Manic Street Preachers", Album: "Forever Delayed"},
+	{Name: "Jona Lewie", Artist: "Mans Wieslander", Album: "Legendary Shortcuts Vol. 1"},
+	{Name: "Snowbird", Artist: "Mark Eitzel", Album: "Music for Courage & Confidence"},
+	{Name: "The Unknown - Radio", Artist: "Mark b", Album: "The Unknown"},
+	{Name: "Need One", Artist: "Martina Topley-Bird", Album: "Quixotic"},
+	{Name: "Oh Regret", Artist: "Mary Lorson", Album: "Tricks for Dawn"},
+	{Name: "Nothing", Artist: "Mason Jennings", Album: "Mason Jennings"},
+	{Name: "Night's End", Artist: "Matt Pond PA", Album: "This Is Not The Green Fury"},
+	{Name: "In My Time", Artist: "Matthew Sweet", Album: "Living Things"},
+	{Name: "The Dark Is Rising", Artist: "Mercury Rev", Album: "All is Dream"},
+	{Name: "The Four Horsemen (Remastered)", Artist: "Metallica", Album: "Kill 'Em All (Remastered)"},
+	{Name: "People In Tha Middle", Artist: "Michael Franti & Spearhead", Album: "Home"},
+	{Name: "Unknown Song", Artist: "Milky Chance", Album: "Unknown Song"},
+	{Name: "Bomb Intro / Pass That Dutch", Artist: "Missy Elliott", Album: "This Is Not a Test!"},
+	{Name: "Work It", Artist: "Missy Elliott", Album: "Under Construction"},
+	{Name: "Athlete", Artist: "Mobblyfe Mac", Album: "Athlete"},
+	{Name: "Tomorrow Will Be Like Today", Artist: "Money Mark", Album: "Push The Button"},
+	{Name: "I’m Back", Artist: "MoneySign Suede", Album: "Moneysign Suede"},
+	{Name: "Roll Away the Stone", Artist: "Mott The Hoople", Album: "The Hoople"},
+	{Name: "Dy-Na-Mi-Tee", Artist: "Ms. Dynamite", Album: "A Little Deeper"},
+	{Name: "Watching Xanadu", Artist: "Mull Historical Society", Album: "Loss"},
+	{Name: "Feeling Good", Artist: "Muse", Album: "Origin of Symmetry"},
+	{Name: "Plug in Baby", Artist: "Muse", Album: "Origin of Symmetry"},
+	{Name: "Time is Running Out", Artist: "Muse", Album: "Absolution"},
+	{Name: "Lowdown", Artist: "My Morning Jacket", Album: "At Dawn"},
+	{Name: "Main Title", Artist: "Münchner Symphoniker", Album: "The Silence Of The Lambs"},
+	{Name: "Bobby James", Artist: "N.E.R.D", Album: "In Search Of..."},
+	{Name: "Provider", Artist: "N.E.R.D", Album: "The Best Of"},
+	{Name: "Provider", Artist: "N.E.R.D", Album: "In Search Of..."},
+	{Name: "Things Are Gonna Get Better", Artist: "NEFFEX", Album: "Things Are Gonna Get Better"},
+	{Name: "Kind & Generous", Artist: "Natalie Merchant", Album: "Ophelia"},
+	{Name: "A Man Needs a Maid - 2009 Remaster", Artist: "Neil Young", Album: "Harvest (2009 Remaster)"},
+	{Name: "After the Goldrush", Artist: "Neil Young", Album: "The Best of The King's Singers"},
+	{Name: "Alabama - 2009 Remaster", Artist: "Neil Young", Album: "Harvest (2009 Remaster)"},
+	{Name: "Heart of Gold", Artist: "Neil Young", Album: "Harvest (50th Anniversary Edition)"},
+	{Name: "My My, Hey Hey (Out of the Blue) - 2016 Remaster", Artist: "Neil Young", Album: "Rust Never Sleeps"},
+	{Name: "Old Man", Artist: "Neil Young", Album: "Harvest (50th Anniversary Edition)"},
+	{Name: "Pardon My Heart - 2016 Remaster", Artist: "Neil Young", Album: "Zuma"},
+	{Name: "60 Miles an Hour", Artist: "New Order", Album: "Get Ready"},
+	{Name: "Crystal - Radio Edit", Artist: "New Order", Album: "2000's-2010's Retrogamer"},
+	{Name: "Here to Stay - Radio Edit; 2015 Remaster", Artist: "New Order", Album: "Singles (2016 Remaster)"},
+	{Name: "Regret - 2015 Remaster", Artist: "New Order", Album: "Republic"},
+	{Name: "Trash", Artist: "New York Dolls", Album: "New York Dolls"},
+	{Name: "Bring It On", Artist: "Nick Cave & The Bad Seeds", Album: "Nocturama"},
+	{Name: "He Wants You", Artist: "Nick Cave & The Bad Seeds", Album: "Nocturama"},
+	{Name: "Into My Arms - 2011 Remastered Version", Artist: "Nick Cave & The Bad Seeds", Album: "The Boatman's Call (2011 Remastered Version)"},
+	{Name: "Love Letter", Artist: "Nick Cave & The Bad Seeds", Album: "No More Shall We Part (2011 - Remaster)"},
+	{Name: "At The Chime Of A City Clock", Artist: "Nick Drake", Album: "Bryter Layter"},
+	{Name: "River Man", Artist: "Nick Drake", Album: "Five Leaves Left"},
+	{Name: "Fight for All the Wrong Reasons", Artist: "Nickelback", Album: "All the Right Reasons"},
+	{Name: "Someday", Artist: "Nickelback", Album: "The Long Road"},
+	{Name: "To Be Young, Gifted and Black - 2005 Remix", Artist: "Nina Simone", Album: "Forever Young, Gifted And Black: Songs Of Freedom And Spirit"},
+	{Name: "The Weather (feat. Rick Ross & Cuzzy Capone)", Artist: "Nipsey Hussle", Album: "Crenshaw"},
+	{Name: "All Apologies", Artist: "Nirvana", Album: "In Utero"},
+	{Name: "Come As You Are", Artist: "Nirvana", Album: "Nevermind (Remastered)"},
+	{Name: "Smells Like Teen Spirit", Artist: "Nirvana", Album: "Nevermind (Remastered)"},
+	{Name: "The Man Who Sold The World - Live", Artist: "Nirvana", Album: "MTV Unplugged In New York"},
+	{Name: "You Know You're Right", Artist: "Nirvana", Album: "Nirvana"},
+	{Name: "Don't Look Back In Anger - Remastered", Artist: "Oasis", Album: "(What's The Story) Morning Glory? (Deluxe Remastered Edition)"},
+	{Name: "Little By Little", Artist: "Oasis", Album: "Heathen Chemistry"},
+	{Name: "Live Forever - Remastered", Artist: "Oasis", Album: "Definitely Maybe (Deluxe Edition Remastered)"},
+	{Name: "Morning Glory - Remastered", Artist: "Oasis", Album: "(What's The Story) Morning Glory? (Deluxe Remastered Edition)"},
+	{Name: "Songbird", Artist: "Oasis", Album: "Heathen Chemistry"},
+	{Name: "Supersonic - Remastered", Artist: "Oasis", Album: "Definitely Maybe (Deluxe Edition Remastered)"},
+	{Name: "The Hindu Times", Artist: "Oasis", Album: "Heathen Chemistry"},
+	{Name: "Got Your Money (feat. Kelis)", Artist: "Ol' Dirty Bastard", Album: "Nigga Please"},
+	{Name: "Hey Ya!", Artist: "Outkast", Album: "Speakerboxxx/The Love Below"},
+	{Name: "Rosa Parks", Artist: "Outkast", Album: "Aquemini"},
+	{Name: "Just Like a Pill", Artist: "P!nk", Album: "M!ssundaztood (Expanded Edition)"},
+	{Name: "Alive - Chris Lord-Alge Mix", Artist: "P.O.D.", Album: "Satellite (U.S. Version)"},
+	{Name: "Down By The Water", Artist: "PJ Harvey", Album: "To Bring You My Love"},
+	{Name: "This Is Love", Artist: "PJ Harvey", Album: "Stories From The City, Stories From The Sea"},
+	{Name: "I Can't Get Enough of Your Love (Originally Performed By Bad Company) [Full Vocal Version]", Artist: "Paris Music", Album: "Karaoke Hits 1974, Vol. 2"},
+	{Name: "Because the Night", Artist: "Patti Smith", Album: "Easter"},
+	{Name: "Gloria: In Excelsis Deo", Artist: "Patti Smith", Album: "Horses (Legacy Edition)"},
+	{Name: "Santa Claus Is Coming To Town", Artist: "Paul Di'Anno", Album: "Heavy Metal Christmas"},
+	{Name: "The Changingman", Artist: "Paul Weller", Album: "Stanley Road"},
+	{Name: "Wild Wood (Sheared Wood Remix) [Paul Weller Vs. Portishead]", Artist: "Paul Weller", Album: "Wild Wood"},
+	{Name: "Blinded By the Stars", Artist: "Pernice Brothers", Album: "Yours, Mine & Ours"},
+	{Name: "Life On a Chain", Artist: "Pete Yorn", Album: "musicforthemorningafter"},
+	{Name: "Solsbury Hill", Artist: "Peter Gabriel", Album: "Peter Gabriel 1: Car (Remastered Version)"},
+	{Name: "Simon Says", Artist: "Pharoahe Monch", Album: "Internal Affairs"},
+	{Name: "Frontin' (feat. Jay-Z) - Club Mix", Artist: "Pharrell Williams", Album: "The Neptunes Present... Clones"},
+	{Name: "Happy - From \"Despicable Me 2\"", Artist: "Pharrell Williams", Album: "G I R L"},
+	{Name: "Growing Up", Artist: "Pickin' On Series", Album: "Pickin' On Bruce Springsteen Volume 2: The Bluegrass Tribute"},
+	{Name: "Debaser", Artist: "Pixies", Album: "Doolittle"},
+	{Name: "The Holiday Song", Artist: "Pixies", Album: "Come On Pilgrim"},
+	{Name: "English Summer Rain", Artist: "Placebo", Album: "Sleeping With Ghosts"},
+	{Name: "Special Needs", Artist: "Placebo", Album: "Sleeping With Ghosts"},
+	{Name: "The Bitter End", Artist: "Placebo", Album: "Sleeping With Ghosts"},
+	{Name: "This Picture", Artist: "Placebo", Album: "Sleeping With Ghosts"},
+	{Name: "2000 Miles - 2007 Remaster", Artist: "Pretenders", Album: "Learning to Crawl (Expanded & Remastered)"},
+	{Name: "Brass in Pocket - 2006 Remaster", Artist: "Pretenders", Album: "Pretenders"},
+	{Name: "Kid - 2006 Remaster", Artist: "Pretenders", Album: "Pretenders"},
+	{Name: "Loaded", Artist: "Primal Scream", Album: "Screamadelica"},
+	{Name: "Raspberry Beret", Artist: "Prince", Album: "Around The World In A Day"},
+	{Name: "Sometimes It Snows in April", Artist: "Prince", Album: "Parade - Music from the Motion Picture Under the Cherry Moon"},
+	{Name: "Bad Babysitter", Artist: "Princess Superstar", Album: "Princess Superstar Is"},
+	{Name: "Honestly - In the Style of Zwan (Karaoke Version Teaching Vocal)", Artist: "ProTracks (Karaoke)", Album: "Karaoke - Rock March 2003"},
+	{Name: "Rise", Artist: "Public Image Ltd.", Album: "Public Image Ltd."},
+	{Name: "Babies", Artist: "Pulp", Album: "His N Hers"},
+	{Name: "Hammer To Fall - Remastered 2011", Artist: "Queen", Album: "Greatest Hits II"},
+	{Name: "Seven Seas Of Rhye - Remastered 2011", Artist: "Queen", Album: "Queen II (Deluxe Remastered Version)"},
+	{Name: "No One Knows", Artist: "Queens of the Stone Age", Album: "Songs For The Deaf"},
+	{Name: "Bad Day - Remastered", Artist: "R.E.M.", Album: "And I Feel Fine.....The Best Of The IRS Years 82-87 Collector's Edition"},
+	{Name: "Electrolite - Remastered", Artist: "R.E.M.", Album: "New Adventures In Hi-Fi (25th Anniversary Edition)"},
+	{Name: "Near Wild Heaven", Artist: "R.E.M.", Album: "Out Of Time (25th Anniversary Edition)"},
+	{Name: "Nightswimming", Artist: "R.E.M.", Album: "Automatic For The People"},
+	{Name: "Orange Crush - Remastered 2013", Artist: "R.E.M.", Album: "Green (Remastered)"},
+	{Name: "What’s The Frequency, Kenneth? - Remastered", Artist: "R.E.M.", Album: "Monster (25th Anniversary Edition)"},
+	{Name: "Feeder", Artist: "R.M.F.C.", Album: "Hive, Vol. 2"},
+	{Name: "Black Star", Artist: "Radiohead", Album: "The Bends"},
+	{Name: "Fake Plastic Trees", Artist: "Radiohead", Album: "The Bends"},
+	{Name: "Go To Sleep", Artist: "Radiohead", Album: "Hail To the Thief"},
+	{Name: "Just", Artist: "Radiohead", Album: "The Bends"},
+	{Name: "Let Down", Artist: "Radiohead", Album: "OK Computer"},
+	{Name: "Paranoid Android", Artist: "Radiohead", Album: "OK Computer"},
+	{Name: "Street Spirit (Fade Out)", Artist: "Radiohead", Album: "The Bends"},
+	{Name: "There, There", Artist: "Radiohead", Album: "Hail To the Thief"},
+	{Name: "Golden Touch - Full Length", Artist: "Razorlight", Album: "Up All Night"},
+	{Name: "By the Way", Artist: "Red Hot Chili Peppers", Album: "By the Way (Deluxe Edition)"},
+	{Name: "Californication", Artist: "Red Hot Chili Peppers", Album: "Californication (Deluxe Edition)"},
+	{Name: "Can't Stop", Artist: "Red Hot Chili Peppers", Album: "By the Way (Deluxe Edition)"},
+	{Name: "Fortune Faded", Artist: "Red Hot Chili Peppers", Album: "Greatest Hits"},
+	{Name: "The Zephyr Song", Artist: "Red Hot Chili Peppers", Album: "Músicas para Dias de Chuva"},
+	{Name: "Under the Bridge", Artist: "Red Hot Chili Peppers", Album: "Blood Sugar Sex Magik (Deluxe Edition)"},
+	{Name: "Universally Speaking", Artist: "Red Hot Chili Peppers", Album: "Camminata Mattutina"},
+	{Name: "Break The Night With Colour", Artist: "Richard Ashcroft", Album: "Keys To The World"},
+	{Name: "Buy It In Bottles", Artist: "Richard Ashcroft", Album: "Human Conditions"},
+	{Name: "Check The Meaning", Artist: "Richard Ashcroft", Album: "Human Conditions"},
+	{Name: "Science Of Silence", Artist: "Richard Ashcroft", Album: "Human Conditions"},
+	{Name: "You On My Mind In My Sleep", Artist: "Richard Ashcroft", Album: "Alone With Everybody"},
+	{Name: "Creep", Artist: "Richard Cheese", Album: "The Sunny Side of the Moon: The Best of Richard Cheese"},
+	{Name: "Sick Jokes - Live", Artist: "Ricky Gervais", Album: "Science (Live)"},
+	{Name: "Chelsea Girl - 2001 Remaster", Artist: "Ride", Album: "Smile"},
+	{Name: "Time of Her Time - 2001 Remaster", Artist: "Ride", Album: "Going Blank Again (Expanded)"},
+	{Name: "Portions for Foxes", Artist: "Rilo Kiley", Album: "More Adventurous (U.S. Release)"},
+	{Name: "Since You've Been Gone - Live", Artist: "Ritchie Blackmore's Rainbow", Album: "Memories in Rock II (Live)"},
+	{Name: "Got None", Artist: "Robert Post", Album: "Robert Post"},
+	{Name: "Handbags and Gladrags - Live Unplugged; 2008 Remaster", Artist: "Rod Stewart", Album: "Unplugged....And Seated"},
+	{Name: "Maggie May", Artist: "Rod Stewart", Album: "Every Picture Tells A Story"},
+	{Name: "The First Cut Is the Deepest", Artist: "Rod Stewart", Album: "A Night on the Town"},
+	{Name: "You Wear It Well", Artist: "Rod Stewart", Album: "Never A Dull Moment"},
+	{Name: "Witness (1 Hope)", Artist: "Roots Manuva", Album: "Run Come Save Me"},
+	{Name: "Street Life", Artist: "Roxy Music", Album: "Stranded"},
+	{Name: "The Spirit Of Radio", Artist: "Rush", Album: "Permanent Waves"},
+	{Name: "Answering Bell", Artist: "Ryan Adams", Album: "Gold"},
+	{Name: "Nuclear", Artist: "Ryan Adams", Album: "Demolition"},
+	{Name: "So Alive", Artist: "Ryan Adams", Album: "Rock N Roll"},
+	{Name: "The Shadowlands", Artist: "Ryan Adams", Album: "Love Is Hell"},
+	{Name: "Wonderwall", Artist: "Ryan Adams", Album: "Love Is Hell"},
+	{Name: "Poor Leno", Artist: "Röyksopp", Album: "Melody A.M."},
+	{Name: "Carrion", Artist: "Sea Power", Album: "The Decline of British Sea Power"},
+	{Name: "Whirling-In-Rags, 8 AM", Artist: "Sea Power", Album: "Disco Elysium"},
+	{Name: "America", Artist: "Simon & Garfunkel", Album: "Bookends"},
+	{Name: "April Come She Will", Artist: "Simon & Garfunkel", Album: "Sounds Of Silence"},
+	{Name: "The Only Living Boy in New York", Artist: "Simon & Garfunkel", Album: "Bridge Over Troubled Water"},
+	{Name: "The Sound of Silence - Acoustic Version", Artist: "Simon & Garfunkel", Album: "Wednesday Morning, 3 A.M."},
+	{Name: "Signs (Karaoke Version) - Originally Performed By Snoop Dogg Ft Charlie Wilson and Justin Timberlake", Artist: "Sing Karaoke Sing", Album: "Remember Me - 2005"},
+	{Name: "The Other Man", Artist: "Sloan", Album: "Pretty Together"},
+	{Name: "If You Want Me to Stay", Artist: "Sly & The Family Stone", Album: "Fresh"},
+	{Name: "Beautiful", Artist: "Snoop Dogg", Album: "Paid Tha Cost To Be Da Bo$$"},
+	{Name: "Snoop Dogg (What's My Name Pt. 2)", Artist: "Snoop Dogg", Album: "Tha Last Meal"},
+	{Name: "Who Am I (What's My Name) - Live", Artist: "Snoop Dogg", Album: "Live at the House Of Blues"},
+	{Name: "Who Am I (What’s My Name)?", Artist: "Snoop Dogg", Album: "Doggystyle"},
+	{Name: "Run", Artist: "Snow Patrol", Album: "Final Straw"},
+	{Name: "Sugar Kane", Artist: "Sonic Youth", Album: "Dirty"},
+	{Name: "Loosen Your Hold", Artist: "South", Album: "Up Close And Personal"},
+	{Name: "Say My Name - 7 Version", Artist: "Spare Snare", Album: "Everybody Knows That"},
+	{Name: "Born Again", Artist: "Starsailor", Album: "Silence Is Easy"},
+	{Name: "Good Souls", Artist: "Starsailor", Album: "Love Is Here"},
+	{Name: "Poor Misguided Fool", Artist: "Starsailor", Album: "Love Is Here"},
+	{Name: "Silence Is Easy", Artist: "Starsailor", Album: "Silence Is Easy"},
+	{Name: "Reeling In The Years", Artist: "Steely Dan", Album: "A Decade Of Steely Dan"},
+	{Name: "Handbags And Gladrags", Artist: "Stereophonics", Album: "Just Enough Education To Perform"},
+	{Name: "Step On My Old Size Nines", Artist: "Stereophonics", Album: "Just Enough Education To Perform"},
+	{Name: "Vegas Two Times", Artist: "Stereophonics", Album: "Just Enough Education To Perform"},
+	{Name: "Vegas Two Times - Live From Dakota / 2005", Artist: "Stereophonics", Album: "Live From Dakota"},
+	{Name: "Fly Like An Eagle", Artist: "Steve Miller Band", Album: "Fly Like An Eagle"},
+	{Name: "I Wish", Artist: "Stevie Wonder", Album: "Songs In The Key Of Life"},
+	{Name: "Living For The City", Artist: "Stevie Wonder", Album: "The Definitive Collection"},
+	{Name: "Living For The City", Artist: "Stevie Wonder", Album: "Innervisions"},
+	{Name: "The Life - Album Version Edit (Explicit)", Artist: "Styles P", Album: "A Gangster And A Gentleman"},
+	{Name: "Push The Button", Artist: "Sugababes", Album: "Taller In More Ways"},
+	{Name: "Hoover Dam", Artist: "Sugar", Album: "Copper Blue (Deluxe Remaster)"},
+	{Name: "If I Can't Change Your Mind", Artist: "Sugar", Album: "Copper Blue (Deluxe Remaster)"},
+	{Name: "In Too Deep", Artist: "Sum 41", Album: "All Killer, No Filler"},
+	{Name: "It's Not the End of the World? (2021 - Remaster)", Artist: "Super Furry Animals", Album: "Rings Around the World (20th Anniversary Edition)"},
+	{Name: "Grace", Artist: "Supergrass", Album: "Life On Other Planets"},
+	{Name: "Late in the Day", Artist: "Supergrass", Album: "In It for the Money"},
+	{Name: "Seen the Light", Artist: "Supergrass", Album: "Life On Other Planets"},
+	{Name: "20th Century Boy", Artist: "T. Rex", Album: "20th Century Boy"},
+	{Name: "Christmas Bop", Artist: "T. Rex", Album: "Christmas Bop"},
+	{Name: "Hold the Line", Artist: "TOTO", Album: "Toto"},
+	{Name: "gold rush", Artist: "Taylor Swift", Album: "evermore"},
+	{Name: "Christmas Eve", Artist: "Teenage Fanclub", Album: "IT'S A COOL, COOL CHRISTMAS"},
+	{Name: "I Need Direction", Artist: "Teenage Fanclub", Album: "Howdy! (Remastered)"},
+	{Name: "Radio", Artist: "Teenage Fanclub", Album: "Thirteen"},
+	{Name: "Sparky's Dream", Artist: "Teenage Fanclub", Album: "Grand Prix"},
+	{Name: "What You Do To Me", Artist: "Teenage Fanclub", Album: "Bandwagonesque"},
+	{Name: "Ice T", Artist: "Tems", Album: "For Broken Ears"},
+	{Name: "Black Snow", Artist: "Ten Benson", Album: "Benson Burner"},
+	{Name: "Frontier Psychiatrist", Artist: "The Avalanches", Album: "Since I Left You (20th Anniversary Deluxe Edition)"},
+	{Name: "Helter Skelter - Remastered 2009", Artist: "The Beatles", Album: "The Beatles (Remastered)"},
+	{Name: "Revolution - Remastered 2009", Artist: "The Beatles", Album: "The Beatles 1967 - 1970 (Remastered)"},
+	{Name: "The Long And Winding Road - Remastered 2009", Artist: "The Beatles", Album: "Let It Be (Remastered)"},
+	{Name: "You've Got To Hide Your Love Away - Remastered 2009", Artist: "The Beatles", Album: "Help! (Remastered)"},
+	{Name: "Squares", Artist: "The Beta Band", Album: "Hot Shots II"},
+	{Name: "For What It's Worth", Artist: "The Cardigans", Album: "Long Gone Before Daylight (Remastered)"},
+	{Name: "You're The Storm", Artist: "The Cardigans", Album: "Long Gone Before Daylight (Remastered)"},
+	{Name: "Star Guitar", Artist: "The Chemical Brothers", Album: "Come With Us"},
+	{Name: "Rock the Casbah - Remastered", Artist: "The Clash", Album: "Combat Rock (Remastered)"},
+	{Name: "White Riot - Remastered", Artist: "The Clash", Album: "The Clash (Remastered)"},
+	{Name: "Who Needs Enemies?", Artist: "The Cooper Temple Clause", Album: "See This Through And Leave"},
+	{Name: "Bill McCai", Artist: "The Coral", Album: "Magic & Medicine"},
+	{Name: "Dreaming of You", Artist: "The Coral", Album: "The Coral"},
+	{Name: "A Forest - 2006 Remaster", Artist: "The Cure", Album: "Seventeen Seconds (Deluxe Edition)"},
+	{Name: "A Man Inside My Mouth", Artist: "The Cure", Album: "Join the Dots: B-Sides and Rarities, 1978-2001 (The Fiction Years)"},
+	{Name: "Catch", Artist: "The Cure", Album: "Kiss Me, Kiss Me, Kiss Me"},
+	{Name: "In Between Days - 2006 Remaster", Artist: "The Cure", Album: "The Head on the Door (Deluxed Edition)"},
+	{Name: "Jumping Someone Else's Train - Single Version", Artist: "The Cure", Album: "Three Imaginary Boys (Deluxe Edition)"},
+	{Name: "Just like Heaven", Artist: "The Cure", Album: "Kiss Me, Kiss Me, Kiss Me"},
+	{Name: "Lovesong - 2010 Remaster", Artist: "The Cure", Album: "Disintegration (Deluxe Edition)"},
+	{Name: "Lullaby - 2010 Remaster", Artist: "The Cure", Album: "Disintegration (Deluxe Edition)"},
+	{Name: "Pictures of You - 2010 Remaster", Artist: "The Cure", Album: "Disintegration (Deluxe Edition)"},
+	{Name: "Six Different Ways - 2006 Remaster", Artist: "The Cure", Album: "The Head on the Door (Deluxed Edition)"},
+	{Name: "Bohemian Like You", Artist: "The Dandy Warhols", Album: "Thirteen Tales From Urban Bohemia"},
+	{Name: "Get Off", Artist: "The Dandy Warhols", Album: "Thirteen Tales From Urban Bohemia"},
+	{Name: "We Used To Be Friends", Artist: "The Dandy Warhols", Album: "Welcome To The Monkey House"},
+	{Name: "Growing on Me", Artist: "The Darkness", Album: "Permission to Land"},
+	{Name: "I Believe in a Thing Called Love", Artist: "The Darkness", Album: "Permission to Land"},
+	{Name: "Soul Kitchen", Artist: "The Doors", Album: "The Doors"},
+	{Name: "Biting The Soles Of My Feet (Same Way Every Day)", Artist: "The Electric Soft Parade", Album: "Holes In The Wall"},
+	{Name: "Left Behind", Artist: "The Electric Soft Parade", Album: "Stages"},
+	{Name: "Do You Realize??", Artist: "The Flaming Lips", Album: "Yoshimi Battles the Pink Robots"},
+	{Name: "Fight Test", Artist: "The Flaming Lips", Album: "Yoshimi Battles the Pink Robots"},
+	{Name: "Race for the Prize - 2017 Remaster", Artist: "The Flaming Lips", Album: "The Soft Bulletin"},
+	{Name: "Yoshimi Battles the Pink Robots, Pt. 1", Artist: "The Flaming Lips", Album: "Yoshimi Battles the Pink Robots"},
+	{Name: "Hate To Say I Told You So", Artist: "The Hives", Album: "Veni Vidi Vicious"},
+	{Name: "Main Offender", Artist: "The Hives", Album: "Veni Vidi Vicious"},
+	{Name: "Bad Time", Artist: "The Jayhawks", Album: "Tomorrow The Green Grass (Legacy Edition)"},
+	{Name: "Bellbottoms", Artist: "The Jon Spencer Blues Explosion", Album: "Orange"},
+	{Name: "Mighty Quinn (Quinn the Eskimo)", Artist: "The Karaoke Channel", Album: "The Karaoke Channel - Sing Mighty Quinn (Quinn the Eskimo) Like Manfred Mann"},
+	{Name: "Somebody Told Me", Artist: "The Killers", Album: "Hot Fuss"},
+	{Name: "Lola (2020 Stereo Remaster)", Artist: "The Kinks", Album: "Lola Versus Powerman and the Moneygoround, Pt. 1"},
+	{Name: "There She Goes", Artist: "The La's", Album: "The La's"},
+	{Name: "If I Could Talk I'd Tell You", Artist: "The Lemonheads", Album: "Car Button Cloth"},
+	{Name: "Don't Look Back into the Sun", Artist: "The Libertines", Album: "Don't Look Back into the Sun"},
+	{Name: "Time for Heroes", Artist: "The Libertines", Album: "Up the Bracket"},
+	{Name: "Animal Nitrate", Artist: "The London Suede", Album: "Suede (25th Anniversary Edition)"},
+	{Name: "Beautiful Ones", Artist: "The London Suede", Album: "Coming up - 20th Anniversary Edition (Audio Version)"},
+	{Name: "Metal Mickey", Artist: "The London Suede", Album: "Suede (25th Anniversary Edition)"},
+	{Name: "Positivity (Remastered)", Artist: "The London Suede", Album: "A New Morning (Remastered)"},
+	{Name: "Stay Together (Long Version) [Remastered]", Artist: "The London Suede", Album: "Dog Man Star (Remastered)"},
+	{Name: "The Wild Ones (Remastered)", Artist: "The London Suede", Album: "Dog Man Star (Remastered)"},
+	{Name: "Forever Lost", Artist: "The Magic Numbers", Album: "The Magic Numbers"},
+	{Name: "Can You Dig It?", Artist: "The Mock Turtles", Album: "Can You Dig It?"},
+	{Name: "Pleasant Valley Sunday - 2007 Remaster", Artist: "The Monkees", Album: "Pisces, Aquarius, Capricorn & Jones Ltd. (Deluxe Edition)"},
+	{Name: "The Shining", Artist: "The Neighbourhood", Album: "Chip Chrome & The Mono-Tones (Deluxe)"},
+	{Name: "Notorious B.I.G. (feat. Lil' Kim & Puff Daddy) - 2005 Remaster", Artist: "The Notorious B.I.G.", Album: "Born Again"},
+	{Name: "Another Girl, Another Planet", Artist: "The Only Ones", Album: "Special View"},
+	{Name: "Another Girl, Another Planet - 2008 re-mastered version", Artist: "The Only Ones", Album: "The Only Ones"},
+	{Name: "A Rainy Night in Soho", Artist: "The Pogues", Album: "Rum Sodomy & The Lash (Expanded Edition)"},
+	{Name: "Fairytale of New York (feat. Kirsty MacColl)", Artist: "The Pogues", Album: "If I Should Fall from Grace with God (Expanded Edition)"},
+	{Name: "Grand Parade", Artist: "The Reindeer Section", Album: "Son of Evil Reindeer"},
+	{Name: "Brown Sugar - Remastered 2009", Artist: "The Rolling Stones", Album: "Sticky Fingers (Remastered)"},
+	{Name: "Gimme Shelter", Artist: "The Rolling Stones", Album: "Let It Bleed"},
+	{Name: "Monkey Man", Artist: "The Rolling Stones", Album: "Let It Bleed"},
+	{Name: "Waiting On A Friend - Remastered 2009", Artist: "The Rolling Stones", Album: "Tattoo You (2009 Re-Mastered)"},
+	{Name: "Wild Horses - 2009 Mix", Artist: "The Rolling Stones", Album: "Sticky Fingers (Remastered)"},
+	{Name: "Sleigh Ride", Artist: "The Ronettes", Album: "A Christmas Gift For You From Phil Spector"},
+	{Name: "The Seed (2.0)", Artist: "The Roots", Album: "The 2000’s Are Back – Best of Hip Hop & RnB"},
+	{Name: "You Got Me", Artist: "The Roots", Album: "Things Fall Apart"},
+	{Name: "Cherub Rock - 2011 Remaster", Artist: "The Smashing Pumpkins", Album: "Siamese Dream (Deluxe Edition)"},
+	{Name: "Untitled", Artist: "The Smashing Pumpkins", Album: "(Rotten Apples) The Smashing Pumpkins Greatest Hits"},
+	{Name: "Ask - 2011 Remaster", Artist: "The Smiths", Album: "Louder Than Bombs"},
+	{Name: "Bigmouth Strikes Again - 2011 Remaster", Artist: "The Smiths", Album: "The Queen Is Dead"},
+	{Name: "Cemetry Gates - 2011 Remaster", Artist: "The Smiths", Album: "The Queen Is Dead"},
+	{Name: "I Don't Owe You Anything - 2011 Remaster", Artist: "The Smiths", Album: "The Smiths"},
+	{Name: "Last Night I Dreamt That Somebody Loved Me - 2011 Remaster", Artist: "The Smiths", Album: "Strangeways, Here We Come"},
+	{Name: "Panic - 2011 Remaster", Artist: "The Smiths", Album: "Louder Than Bombs"},
+	{Name: "There Is a Light That Never Goes Out - 2011 Remaster", Artist: "The Smiths", Album: "The Queen Is Dead"},
+	{Name: "A Message to You Rudy - 2015 Remaster", Artist: "The Specials", Album: "The Specials (Deluxe Version)"},
+	{Name: "Elephant Stone - Remastered", Artist: "The Stone Roses", Album: "The Stone Roses (20th Anniversary Collector's Edition)"},
+	{Name: "Fools Gold - Remastered", Artist: "The Stone Roses", Album: "The Stone Roses"},
+	{Name: "I Wanna Be Adored - Remastered", Artist: "The Stone Roses", Album: "The Stone Roses"},
+	{Name: "Has It Come to This?", Artist: "The Streets", Album: "Original Pirate Material"},
+	{Name: "It's Too Late", Artist: "The Streets", Album: "Original Pirate Material"},
+	{Name: "Let's Push Things Forward", Artist: "The Streets", Album: "Original Pirate Material"},
+	{Name: "Weak Become Heroes", Artist: "The Streets", Album: "Original Pirate Material"},
+	{Name: "Call It Fate, Call It Karma", Artist: "The Strokes", Album: "Comedown Machine"},
+	{Name: "Hard To Explain", Artist: "The Strokes", Album: "Is This It"},
+	{Name: "Last Nite", Artist: "The Strokes", Album: "Is This It"},
+	{Name: "Someday", Artist: "The Strokes", Album: "Is This It"},
+	{Name: "The Adults Are Talking", Artist: "The Strokes", Album: "The New Abnormal"},
+	{Name: "Hit", Artist: "The Sugarcubes", Album: "Stick Around For Joy"},
+	{Name: "Jamming With 7 Nation Army by the White Stripes", Artist: "The Sweetness of Stress", Album: "Jamming With 5 Rock Classics"},
+	{Name: "No Blue Sky", Artist: "The Thorns", Album: "The Thorns"},
+	{Name: "Big Sur", Artist: "The Thrills", Album: "So Much For The City"},
+	{Name: "One Horse Town", Artist: "The Thrills", Album: "So Much For The City"},
+	{Name: "Santa Cruz (You're Not That Far)", Artist: "The Thrills", Album: "So Much For The City"},
+	{Name: "Teenage Kicks", Artist: "The Undertones", Album: "The Undertones"},
+	{Name: "I'm Waiting For The Man", Artist: "The Velvet Underground", Album: "The Velvet Underground & Nico 45th Anniversary"},
+	{Name: "Venus In Furs", Artist: "The Velvet Underground", Album: "The Velvet Underground & Nico 45th Anniversary"},
+	{Name: "Lucky Man", Artist: "The Verve", Album: "Urban Hymns (Deluxe / Remastered 2016)"},
+	{Name: "Sonnet", Artist: "The Verve", Album: "Urban Hymns (Remastered 2016)"},
+	{Name: "Get Free", Artist: "The Vines", Album: "Highly Evolved"},
+	{Name: "Homesick", Artist: "The Vines", Album: "Highly Evolved"},
+	{Name: "Ms. Jackson", Artist: "The Vines", Album: "Deep Cuts"},
+	{Name: "Pleasant Valley Sunday", Artist: "The Wedding Present", Album: "The Hit Parade"},
+	{Name: "Out of Time", Artist: "The Weeknd", Album: "Dawn FM"},
+	{Name: "Dead Leaves and the Dirty Ground", Artist: "The White Stripes", Album: "White Blood Cells"},
+	{Name: "Fell In Love With a Girl", Artist: "The White Stripes", Album: "White Blood Cells"},
+	{Name: "Hotel Yorba", Artist: "The White Stripes", Album: "White Blood Cells"},
+	{Name: "I Just Don't Know What to Do With Myself", Artist: "The White Stripes", Album: "Elephant"},
+	{Name: "Jolene", Artist: "The White Stripes", Album: "Hello Operator"},
+	{Name: "Seven Nation Army", Artist: "The White Stripes", Album: "Elephant"},
+	{Name: "The Hardest Button to Button", Artist: "The White Stripes", Album: "Elephant"},
+	{Name: "Pinball Wizard", Artist: "The Who", Album: "Tommy"},
+	{Name: "The Seeker - Original Single A-Side Mix", Artist: "The Who", Album: "Who’s Next : Life House (Super Deluxe)"},
+	{Name: "Won't Get Fooled Again - Original Album Version", Artist: "The Who", Album: "Who's Next (Deluxe Edition)"},
+	{Name: "Time of the Season - Mono Version", Artist: "The Zombies", Album: "Odessey and Oracle"},
+	{Name: "Gloria (feat. Van Morrison) - Stereo Version", Artist: "Them", Album: "The Essential Van Morrison"},
+	{Name: "Don't Believe A Word", Artist: "Thin Lizzy", Album: "Johnny The Fox"},
+	{Name: "The Boys Are Back In Town", Artist: "Thin Lizzy", Album: "Jailbreak (Deluxe Edition)"},
+	{Name: "Waiting for an Alibi", Artist: "Thin Lizzy", Album: "Black Rose: A Rock Legend"},
+	{Name: "Buzzin' Fly", Artist: "Tim Buckley", Album: "Happy Sad"},
+	{Name: "Wings", Artist: "Tim Buckley", Album: "Tim Buckley"},
+	{Name: "Oh My Corazon", Artist: "Tim Burgess", Album: "I Believe"},
+	{Name: "End of the World News (Dose Me Up) - 2020 Remaster", Artist: "Tom McRae", Album: "Tom McRae (2020 Remaster)"},
+	{Name: "Breakdown", Artist: "Tom Petty and the Heartbreakers", Album: "Tom Petty & The Heartbreakers"},
+	{Name: "Downtown Train - 2023 Remaster", Artist: "Tom Waits", Album: "Rain Dogs (2023 Remaster)"},
+	{Name: "Ol' 55", Artist: "Tom Waits", Album: "Closing Time (Remastered)"},
+	{Name: "SICKO MODE", Artist: "Travis Scott", Album: "ASTROWORLD"},
+	{Name: "Driftwood", Artist: "Travis", Album: "The Man Who"},
+	{Name: "Flowers In The Window", Artist: "Travis", Album: "The Invisible Band"},
+	{Name: "Love Will Come Through", Artist: "Travis", Album: "12 Memories"},
+	{Name: "The Beautiful Occupation", Artist: "Travis", Album: "12 Memories"},
+	{Name: "song that plays when you adventure into the unknown, never to be seen again", Artist: "TsukiInkling", Album: "Chaos Roulette: Section I"},
+	{Name: "Fishing For A Dream", Artist: "Turin Brakes", Album: "JackInABox"},
+	{Name: "Long Distance", Artist: "Turin Brakes", Album: "Ether Song"},
+	{Name: "Mind Over Money", Artist: "Turin Brakes", Album: "The Optimist"},
+	{Name: "Pain Killer (Summer Rain)", Artist: "Turin Brakes", Album: "Painkiller"},
+	{Name: "Pain Killer (Summer Rain)", Artist: "Turin Brakes", Album: "Ether Song"},
+	{Name: "Beautiful Day", Artist: "U2", Album: "All That You Can't Leave Behind"},
+	{Name: "Electrical Storm - William Orbit Mix", Artist: "U2", Album: "The Best Of 1990-2000"},
+	{Name: "Stuck In A Moment You Can't Get Out Of", Artist: "U2", Album: "All That You Can't Leave Behind"},
+	{Name: "The Ground Beneath Her Feet", Artist: "U2", Album: "All That You Can’t Leave Behind (20th Anniversary Edition / Super Deluxe / Remastered 2020)"},
+	{Name: "Walk On", Artist: "U2", Album: "All That You Can't Leave Behind"},
+	{Name: "With Or Without You", Artist: "U2", Album: "The Joshua Tree (Super Deluxe)"},
+	{Name: "Jump - 2015 Remaster", Artist: "Van Halen", Album: "1984 (Remastered)"},
+	{Name: "The Streets - Re-Twist", Artist: "WC", Album: "Ghetto Heisman"},
+	{Name: "Regulate", Artist: "Warren G", Album: "Regulate… G Funk Era"},
+	{Name: "Ain't That Pretty at All - 2007 Remaster", Artist: "Warren Zevon", Album: "The Envoy"},
+	{Name: "Werewolves of London", Artist: "Warren Zevon", Album: "Excitable Boy"},
+	{Name: "Magic Numbers", Artist: "Wax Tailor", Album: "Dusty Rainbow from the Dark"},
+	{Name: "Dope Nose", Artist: "Weezer", Album: "Maladroit"},
+	{Name: "Island In The Sun", Artist: "Weezer", Album: "Weezer"},
+	{Name: "Shinobi vs. Dragon Ninja", Artist: "White Knight Instrumental", Album: "Instrumental Covers of Lost Prophets"},
+	{Name: "The Fake Sound of Progress", Artist: "White Knight Instrumental", Album: "Instrumental Covers of Lost Prophets"},
+	{Name: "I'm the Man Who Loves You - 2022 Remaster", Artist: "Wilco", Album: "Yankee Hotel Foxtrot (2022 Remaster)"},
+	{Name: "You Haven't Done Nothing", Artist: "Wildlife", Album: "Celebrating Stevie Wonder"},
+	{Name: "Is It Like Today?", Artist: "World Party", Album: "Bang!"},
+	{Name: "Put the Message In the Box", Artist: "World Party", Album: "Goodbye Jumbo"},
+	{Name: "Gravel Pit (feat. RZA, Method Man, Ghostface Killah, Raekwon & U-God)", Artist: "Wu-Tang Clan", Album: "Legend Of The Wu-Tang: Wu-Tang Clan's Greatest Hits"},
+	{Name: "Uzi (Pinky Ring) (feat. U-God, Raekwon, Ghostface Killah, RZA, Method Man, Inspectah Deck, Masta Killa & GZA)", Artist: "Wu-Tang Clan", Album: "Wu-Tang Iron Flag"},
+	{Name: "Lazy (feat. David Byrne)", Artist: "X-Press 2", Album: "Raise Your Hands"},
+	{Name: "Making Plans For Nigel", Artist: "XTC", Album: "Drums And Wires"},
+	{Name: "X", Artist: "Xzibit", Album: "Restless"},
+	{Name: "Lilywhite", Artist: "Yusuf / Cat Stevens", Album: "Mona Bone Jakon (Remastered 2020)"},
+	{Name: "Miles From Nowhere", Artist: "Yusuf / Cat Stevens", Album: "Tea For The Tillerman (Remastered 2020)"},
+	{Name: "Peace Train - Remastered 2021", Artist: "Yusuf / Cat Stevens", Album: "Teaser And The Firecat (Remastered 2021)"},
+	{Name: "Sitting - Remastered 2022", Artist: "Yusuf / Cat Stevens", Album: "Catch Bull At Four (Remastered 2022)"},
+	{Name: "The Wind - Remastered 2021", Artist: "Yusuf / Cat Stevens", Album: "Teaser And The Firecat (Remastered 2021)"},
+	{Name: "Trouble", Artist: "Yusuf / Cat Stevens", Album: "Mona Bone Jakon (Remastered 2020)"},
+	{Name: "Distractions", Artist: "Zero 7", Album: "Simple Things"},
+	{Name: "Someday (Originally By The Strokes) [Karaoke Version]", Artist: "Zoom Karaoke", Album: "Zoom Karaoke Gap Fillers, Vol. 88"},
+	{Name: "Fox", Artist: "vern matz", Album: "The Bronze Age"},
+}
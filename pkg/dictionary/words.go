@@ -0,0 +1,41 @@
+package dictionary
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var validLetters = regexp.MustCompile(`^[A-Za-z]+$`)
+
+// Words is a set of upper-case dictionary words.
+type Words map[string]struct{}
+
+func (w Words) Contains(word string) bool {
+	_, ok := w[strings.ToUpper(word)]
+	return ok
+}
+
+// LoadWords loads a dictionary with one word per line e.g. etc/sowpods.txt.
+// Lines containing anything other than letters are ignored.
+func LoadWords(path string) (Words, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open words file: %w", err)
+	}
+	defer f.Close()
+
+	words := make(Words)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if validLetters.Match(scanner.Bytes()) {
+			words[strings.ToUpper(scanner.Text())] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan dictionary: %w", err)
+	}
+	return words, nil
+}
@@ -61,8 +61,9 @@ func NewBot(
 		for k, v := range c.ModalHandlers() {
 			bot.modalHandlers[fmt.Sprintf("%s:%s", c.Prefix(), k)] = v
 		}
+		// autocomplete handlers are keyed by the sub command they complete options for.
 		for k, v := range c.AutoCompleteHandlers() {
-			bot.autoCompleteHandlers[fmt.Sprintf("%s:%s", c.Prefix(), k)] = v
+			bot.autoCompleteHandlers[fmt.Sprintf("%s:%s", c.RootCommand(), k)] = v
 		}
 		for k, v := range c.CommandHandlers() {
 			if bot.commandHandlers[c.RootCommand()] == nil {
@@ -101,13 +102,14 @@ func (b *Bot) Start() error {
 			}
 			return
 		case discordgo.InteractionApplicationCommandAutocomplete:
-			if h, ok := b.autoCompleteHandlers[i.ApplicationCommandData().Name]; ok {
+			name := autoCompleteHandlerName(i)
+			if h, ok := b.autoCompleteHandlers[name]; ok {
 				if err := h(s, i); err != nil {
-					b.respondError(s, i, err)
+					b.respondAutocompleteError(s, i, err)
 				}
 				return
 			}
-			b.respondError(s, i, fmt.Errorf("no handler for autocomplete action: %s", i.ApplicationCommandData().Name))
+			b.respondAutocompleteError(s, i, fmt.Errorf("no handler for autocomplete action: %s", name))
 			return
 		case discordgo.InteractionModalSubmit:
 			// prefix match buttons to allow additional data in the customID
@@ -175,6 +177,31 @@ func (b *Bot) respondError(s *discordgo.Session, i *discordgo.InteractionCreate,
 	}
 }
 
+// respondAutocompleteError logs the error and responds with no choices since autocomplete interactions cannot be
+// answered with a message.
+func (b *Bot) respondAutocompleteError(s *discordgo.Session, i *discordgo.InteractionCreate, err error) {
+	b.logger.Error("Autocomplete failed: "+err.Error(), slog.String("handler", autoCompleteHandlerName(i)))
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: []*discordgo.ApplicationCommandOptionChoice{},
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond", slog.String("err", err.Error()))
+	}
+}
+
+// autoCompleteHandlerName returns the game and sub command being completed e.g. crossword:answer.
+// The top level command name is always the bot name so cannot be used.
+func autoCompleteHandlerName(i *discordgo.InteractionCreate) string {
+	data := i.ApplicationCommandData()
+	if len(data.Options) == 0 || len(data.Options[0].Options) == 0 {
+		return data.Name
+	}
+	return fmt.Sprintf("%s:%s", data.Options[0].Name, data.Options[0].Options[0].Name)
+}
+
 func (b *Bot) handleRootCommand(s *discordgo.Session, i *discordgo.InteractionCreate) error {
 
 	subCommand := i.ApplicationCommandData().Options[0]
@@ -0,0 +1,142 @@
+package command
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/gamesmaster/pkg/render"
+	"github.com/warmans/gamesmaster/pkg/util"
+)
+
+// BoardPage is one encoded page of a game board.
+type BoardPage struct {
+	Number      int
+	First       int
+	Last        int
+	Key         string
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// File returns a new attachment for the page. Each upload needs its own reader.
+func (p *BoardPage) File() *discordgo.File {
+	return &discordgo.File{Name: p.Name, ContentType: p.ContentType, Reader: bytes.NewReader(p.Data)}
+}
+
+// EncodeBoard encodes each page within the file size limit. Pages are named after the game e.g.
+// Filmgame.png, Filmgame-2.png.
+func EncodeBoard(name string, pages []*render.Page, maxFileSize int) ([]*BoardPage, error) {
+	encoded := make([]*BoardPage, len(pages))
+	for k, v := range pages {
+		buff, ext, err := render.Encode(v.Canvas, maxFileSize)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", v.Number, err)
+		}
+		fileName := fmt.Sprintf("%s.%s", name, ext)
+		if v.Number > 1 {
+			fileName = fmt.Sprintf("%s-%d.%s", name, v.Number, ext)
+		}
+		contentType := "image/png"
+		if ext == "jpg" {
+			contentType = "image/jpeg"
+		}
+		encoded[k] = &BoardPage{
+			Number:      v.Number,
+			First:       v.First,
+			Last:        v.Last,
+			Key:         v.Key,
+			Name:        fileName,
+			ContentType: contentType,
+			Data:        buff.Bytes(),
+		}
+	}
+	return encoded, nil
+}
+
+func boardPageDescription(page *BoardPage, numPages int) string {
+	return fmt.Sprintf("Page %d/%d (#%d-%d)", page.Number, numPages, page.First, page.Last)
+}
+
+// BoardPages remembers the version of each page that was last uploaded so pages are only re-uploaded when they change.
+type BoardPages struct {
+	mu       sync.Mutex
+	uploaded map[string]string
+}
+
+func (b *BoardPages) changed(messageID string, page *BoardPage) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.uploaded == nil || b.uploaded[messageID] != page.Key
+}
+
+// Uploaded records the page shown in the message.
+func (b *BoardPages) Uploaded(messageID string, page *BoardPage) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.uploaded == nil {
+		b.uploaded = map[string]string{}
+	}
+	b.uploaded[messageID] = page.Key
+}
+
+// SendExtra sends each page after the first as a separate message and returns the message IDs. The first page
+// is sent along with the game description so it is left to the caller.
+func (b *BoardPages) SendExtra(s *discordgo.Session, channelID string, pages []*BoardPage) ([]string, error) {
+	var messageIDs []string
+	for _, page := range pages[1:] {
+		msg, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+			Content: boardPageDescription(page, len(pages)),
+			Files:   []*discordgo.File{page.File()},
+		})
+		if err != nil {
+			return messageIDs, err
+		}
+		b.Uploaded(msg.ID, page)
+		messageIDs = append(messageIDs, msg.ID)
+	}
+	return messageIDs, nil
+}
+
+// Edit updates the messages showing the board. The first page is always updated since its message has the
+// description, the other pages are only updated if they changed. Pages without a message e.g. for games
+// started before boards were paginated are skipped.
+func (b *BoardPages) Edit(
+	s *discordgo.Session,
+	channelID string,
+	firstMessageID string,
+	pageMessageIDs []string,
+	content string,
+	pages []*BoardPage,
+) error {
+	for k, page := range pages {
+		messageID := firstMessageID
+		pageContent := content
+		if k > 0 {
+			if k > len(pageMessageIDs) {
+				break
+			}
+			messageID = pageMessageIDs[k-1]
+			pageContent = boardPageDescription(page, len(pages))
+			if !b.changed(messageID, page) {
+				continue
+			}
+		}
+		_, err := s.ChannelMessageEditComplex(
+			&discordgo.MessageEdit{
+				Channel:     channelID,
+				ID:          messageID,
+				Content:     util.ToPtr(pageContent),
+				Files:       []*discordgo.File{page.File()},
+				Attachments: util.ToPtr([]*discordgo.MessageAttachment{}),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update page %d: %w", page.Number, err)
+		}
+		b.Uploaded(messageID, page)
+	}
+	return nil
+}
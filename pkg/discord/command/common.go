@@ -1,9 +1,184 @@
 package command
 
 import (
+	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+	"github.com/warmans/gamesmaster/pkg/util"
 )
 
-var posterGuessRegex = regexp.MustCompile(`[Gg]uess\s([0-9]+)\s(.+)`)
+// the number may have a crossword direction e.g. guess A3 fargo
+var posterGuessRegex = regexp.MustCompile(`[Gg]uess\s([ADad]?[0-9]+)\s(.+)`)
 var posterClueRegex = regexp.MustCompile(`[Cc]lue\s([0-9]+)`)
 var adminRegex = regexp.MustCompile(`[Aa]dmin\s(.+)`)
+
+// e.g. admin alias 3 LOTR
+var adminEditAnswersRegex = regexp.MustCompile(`^(alias|unalias|reject|unreject)\s([0-9]+)\s(.+)$`)
+
+// e.g. admin clear someuser
+var adminClearCooldownRegex = regexp.MustCompile(`^clear\s(.+)$`)
+
+// e.g. admin extend 2h
+var adminExtendRegex = regexp.MustCompile(`^(extend|shorten)\s(.+)$`)
+
+const rateLimitedReaction = "⏳"
+
+// StartDurationOptionName is the optional start command option that overrides the game's duration.
+const StartDurationOptionName = "duration"
+
+// StartDurationOption is the option definition for StartDurationOptionName.
+func StartDurationOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        StartDurationOptionName,
+		Description: "How long the game runs e.g. 36h (defaults to the duration given when the game was created).",
+		Type:        discordgo.ApplicationCommandOptionString,
+		Required:    false,
+	}
+}
+
+// StartDuration returns the duration given to a start command or zero if none was given.
+func StartDuration(i *discordgo.InteractionCreate) (time.Duration, error) {
+	for _, o := range i.ApplicationCommandData().Options[0].Options[0].Options {
+		if o.Name == StartDurationOptionName {
+			duration, err := time.ParseDuration(strings.TrimSpace(o.StringValue()))
+			if err != nil || duration <= 0 {
+				return 0, fmt.Errorf("invalid duration: %s", o.StringValue())
+			}
+			return duration, nil
+		}
+	}
+	return 0, nil
+}
+
+// ParseExtendAdminAction parses an admin action that extends (extend 2h) or shortens (shorten 30m) a game. The
+// returned duration is negative if the game should be shortened. It returns false if the action was not an
+// extend action.
+func ParseExtendAdminAction(action string) (time.Duration, bool, error) {
+	matches := adminExtendRegex.FindStringSubmatch(action)
+	if matches == nil {
+		return 0, false, nil
+	}
+	by, err := time.ParseDuration(strings.TrimSpace(matches[2]))
+	if err != nil || by <= 0 {
+		return 0, true, fmt.Errorf("invalid duration: %s", matches[2])
+	}
+	if matches[1] == "shorten" {
+		by = -by
+	}
+	return by, true, nil
+}
+
+// EndTimeMessage describes the new end time of a game after it was extended or shortened.
+func EndTimeMessage(timeLeft time.Duration) string {
+	if timeLeft <= 0 {
+		return "The game has run out of time and will end shortly."
+	}
+	return fmt.Sprintf("The game now ends in %s.", timeLeft.Truncate(time.Minute))
+}
+
+// editAnswers applies an admin alias/reject action to an item's accepted and rejected answers.
+func editAnswers(action string, value string, aliases []string, rejected []string) ([]string, []string) {
+	value = strings.TrimSpace(value)
+	matchesValue := func(v string) bool {
+		return strings.EqualFold(v, value)
+	}
+	switch action {
+	case "alias":
+		if !slices.ContainsFunc(aliases, matchesValue) {
+			aliases = append(aliases, value)
+		}
+		rejected = slices.DeleteFunc(rejected, matchesValue)
+	case "unalias":
+		aliases = slices.DeleteFunc(aliases, matchesValue)
+	case "reject":
+		if !slices.ContainsFunc(rejected, matchesValue) {
+			rejected = append(rejected, value)
+		}
+		aliases = slices.DeleteFunc(aliases, matchesValue)
+	case "unreject":
+		rejected = slices.DeleteFunc(rejected, matchesValue)
+	}
+	return aliases, rejected
+}
+
+// GuessReaction returns the reaction for a guess that was not accepted. Close and partial
+// guesses are only distinguished from wrong guesses if closeFeedback is enabled.
+func GuessReaction(result util.GuessResult, closeFeedback bool) string {
+	if closeFeedback {
+		switch result {
+		case util.GuessClose:
+			return "🤏"
+		case util.GuessPartial:
+			return "🧩"
+		}
+	}
+	return "❌"
+}
+
+// AllowGuess checks the user has not exceeded the guess limit for the item. If they have the
+// message is reacted to and false is returned. Guesses are counted separately for each game. The
+// override replaces the limiter's limits for games that have their own (nil to use the limiter's).
+func AllowGuess(s *discordgo.Session, limiter *ratelimit.Limiter, override *ratelimit.Override, game string, userName string, item string, channelID string, messageID string) (bool, error) {
+	if limiter.AllowOverride(game, userName, item, override) {
+		return true, nil
+	}
+	return false, s.MessageReactionAdd(channelID, messageID, rateLimitedReaction)
+}
+
+// HandleCooldownAdminAction handles the admin actions for listing (cooldowns) and clearing (clear [user])
+// guess cooldowns. It returns false if the action was not a cooldown action.
+func HandleCooldownAdminAction(s *discordgo.Session, limiter *ratelimit.Limiter, action string, channelID string, messageID string) (bool, error) {
+	if action == "cooldowns" {
+		_, err := s.ChannelMessageSend(channelID, renderCooldowns(limiter.Cooldowns()))
+		return true, err
+	}
+	if clearMatches := adminClearCooldownRegex.FindStringSubmatch(action); clearMatches != nil {
+		if limiter.Clear(strings.TrimSpace(clearMatches[1])) == 0 {
+			return true, s.MessageReactionAdd(channelID, messageID, "🤷")
+		}
+		return true, s.MessageReactionAdd(channelID, messageID, "👍")
+	}
+	return false, nil
+}
+
+func renderCooldowns(cooldowns []ratelimit.Cooldown) string {
+	if len(cooldowns) == 0 {
+		return "No active cooldowns."
+	}
+	sb := &strings.Builder{}
+	for _, v := range cooldowns {
+		item := ""
+		if v.Item != "" {
+			item = fmt.Sprintf(" (%s)", v.Item)
+		}
+		fmt.Fprintf(sb, "%s %s%s: %s remaining\n", rateLimitedReaction, v.User, item, time.Until(v.Until).Round(time.Second))
+	}
+	return sb.String()
+}
+
+// interactionUsername returns the username of the user that triggered the interaction. This should match the
+// author username of messages, so scores are the same whichever way a user answers.
+func interactionUsername(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.Username
+	}
+	if i.User != nil {
+		return i.User.Username
+	}
+	return ""
+}
+
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags:   discordgo.MessageFlagsEphemeral,
+			Content: content,
+		},
+	})
+}
@@ -3,30 +3,123 @@ package command
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"image/color"
+	"log"
+	"math/rand"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
 	"github.com/warmans/gamesmaster/pkg/discord"
+	"github.com/warmans/gamesmaster/pkg/puzzle"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
 	"github.com/warmans/gamesmaster/pkg/scores"
 	"github.com/warmans/gamesmaster/pkg/util"
 	"github.com/warmans/go-crossword/v2"
+	"golang.org/x/image/font/gofont/goitalic"
 )
 
+var pencilFont *truetype.Font
+
+func init() {
+	var err error
+	pencilFont, err = truetype.Parse(goitalic.TTF)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 var answerRegex = regexp.MustCompile(`([AD][0-9]+)\s(.+)`)
+var hintRegex = regexp.MustCompile(`^[Hh]int\s([ADad][0-9]+)$`)
+var pencilRegex = regexp.MustCompile(`^[Pp]encil\s([ADad][0-9]+)\s(.+)$`)
+var pencilActionRegex = regexp.MustCompile(`^([Ee]rase|[Cc]onfirm|[Pp]attern)\s([ADad][0-9]+)$`)
+
+var pencilColor = color.RGBA{R: 30, G: 90, B: 200, A: 255}
+
+type CrosswordConfig struct {
+	// CloseFeedback enables reactions for guesses that were close or partially right.
+	CloseFeedback bool
+	// MaxHintsPerClue is the number of letters that can be revealed for each clue. Zero disables hints.
+	MaxHintsPerClue int
+	// HintPenalty is the number of points deducted from a user each time they request a hint.
+	HintPenalty int
+	// HintCooldown is the minimum time between hints for the same clue.
+	HintCooldown time.Duration
+	// Duration is how long the game runs before it is automatically completed. Zero means no time limit.
+	Duration time.Duration
+	// ReminderInterval is how often the remaining time is posted to the thread. Zero disables reminders.
+	ReminderInterval time.Duration
+	// GuessLimits replaces the bot's guess limits for this game (nil to use the bot's limits).
+	GuessLimits *ratelimit.Override
+}
+
+func (c *CrosswordConfig) CloseFeedbackEnabled() bool {
+	return c != nil && c.CloseFeedback
+}
+
+func (c *CrosswordConfig) HintsEnabled() bool {
+	return c != nil && c.MaxHintsPerClue > 0
+}
+
+func (c *CrosswordConfig) Timed() bool {
+	return c != nil && c.Duration > 0
+}
+
+func (c *CrosswordConfig) GuessLimitOverride() *ratelimit.Override {
+	if c == nil {
+		return nil
+	}
+	return c.GuessLimits
+}
 
 type CrosswordState struct {
 	ThreadTitle            string
+	Cfg                    *CrosswordConfig
 	OriginalMessageID      string
 	OriginalMessageChannel string
 	AnswerThreadID         string
 	Game                   *crossword.Crossword
 	Scores                 *scores.Tiered
 	Complete               bool
+	// LastHint is the time a hint was last given for each clue ID.
+	LastHint     map[string]time.Time
+	StartedAt    time.Time
+	LastReminder time.Time
+	// Pencil are the tentative answers for each clue ID.
+	Pencil map[string]*PencilMark
+}
+
+// PencilMark is a tentative answer. It is shown on the board but not scored until it is confirmed.
+type PencilMark struct {
+	Answer string
+	User   string
+	At     time.Time
+}
+
+// TimeLeft returns the remaining time for a timed game.
+func (c *CrosswordState) TimeLeft() time.Duration {
+	if c.StartedAt.IsZero() {
+		return c.Cfg.Duration
+	}
+	return max(0, c.Cfg.Duration-time.Since(c.StartedAt))
+}
+
+func (c *CrosswordState) Unsolved() []crossword.Placement {
+	var unsolved []crossword.Placement
+	for _, w := range c.Game.Words {
+		if !w.Solved {
+			unsolved = append(unsolved, w)
+		}
+	}
+	return unsolved
 }
 
 const (
@@ -34,18 +127,52 @@ const (
 )
 
 const (
-	crosswordCmdStart string = "start"
+	crosswordCmdStart    string = "start"
+	crosswordCmdExport   string = "export"
+	crosswordCmdAnswer   string = "answer"
+	crosswordCmdSchedule string = "schedule"
+)
+
+const (
+	crosswordAnswerModal       = "answer"
+	crosswordAnswerModalClue   = "clue"
+	crosswordAnswerModalAnswer = "answer"
 )
 
-const threadText = "Submit an answer in the format `[clue ID] [answer]` e.g. `A3 Foo`"
+const threadText = "Submit an answer in the thread in the format `[clue ID] [answer]` e.g. `A3 Foo` or use the Answer button."
 
-func NewCrosswordCommand() *Crossword {
-	return &Crossword{}
+const pencilText = "Share a tentative answer with `pencil [clue ID] [answer]`, then `confirm [clue ID]` or `erase [clue ID]`. See the known letters with `pattern [clue ID]`."
+
+func crosswordThreadText(cw *CrosswordState) string {
+	text := threadText + "\n" + pencilText
+	if cw.Cfg.HintsEnabled() {
+		text += fmt.Sprintf(
+			"\nReveal a letter with `hint [clue ID]` e.g. `hint A3` (costs %d point(s), max %d per clue).",
+			cw.Cfg.HintPenalty,
+			cw.Cfg.MaxHintsPerClue,
+		)
+	}
+	if cw.Complete {
+		return text + "\nThe game is over."
+	}
+	if cw.Cfg.Timed() {
+		text += fmt.Sprintf("\nYou have %s remaining to complete the puzzle.", cw.TimeLeft().Truncate(time.Minute).String())
+	}
+	return text
+}
+
+func NewCrosswordCommand(globalSession *discordgo.Session, guessLimits ratelimit.Config) *Crossword {
+	c := &Crossword{globalSession: globalSession, guessLimiter: ratelimit.NewLimiter(guessLimits)}
+	go c.start()
+	return c
 }
 
 type Crossword struct {
+	globalSession  *discordgo.Session
 	gameLock       sync.RWMutex
+	scheduleLock   sync.Mutex
 	answerThreadID string
+	guessLimiter   *ratelimit.Limiter
 }
 
 func (c *Crossword) Prefix() string {
@@ -61,20 +188,29 @@ func (c *Crossword) Description() string {
 }
 
 func (c *Crossword) AutoCompleteHandlers() discord.InteractionHandlers {
-	return discord.InteractionHandlers{}
+	return discord.InteractionHandlers{
+		crosswordCmdAnswer: c.autocompleteClue,
+	}
 }
 
 func (c *Crossword) ButtonHandlers() discord.InteractionHandlers {
-	return discord.InteractionHandlers{}
+	return discord.InteractionHandlers{
+		crosswordAnswerModal: c.openAnswerModal,
+	}
 }
 
 func (c *Crossword) ModalHandlers() discord.InteractionHandlers {
-	return discord.InteractionHandlers{}
+	return discord.InteractionHandlers{
+		crosswordAnswerModal: c.submitAnswerModal,
+	}
 }
 
 func (c *Crossword) CommandHandlers() discord.InteractionHandlers {
 	return discord.InteractionHandlers{
-		crosswordCmdStart: c.startCrossword,
+		crosswordCmdStart:    c.startCrossword,
+		crosswordCmdExport:   c.exportCrossword,
+		crosswordCmdAnswer:   c.answerCrossword,
+		crosswordCmdSchedule: c.scheduleCrossword,
 	}
 }
 
@@ -102,10 +238,40 @@ func (c *Crossword) MessageHandlers() discord.MessageHandlers {
 					}
 				}
 
+				hintMatches := hintRegex.FindStringSubmatch(m.Content)
+				if hintMatches != nil {
+					if err := c.handleRequestHint(s, hintMatches[1], m.ChannelID, m.ID, m.Author.Username); err != nil {
+						fmt.Println("Failed to give hint: ", err.Error())
+					}
+					return
+				}
+
+				// must be checked before answers since they would also match the answer regex.
+				pencilMatches := pencilRegex.FindStringSubmatch(m.Content)
+				if pencilMatches != nil {
+					if err := c.handlePencil(s, pencilMatches[1], pencilMatches[2], m.ChannelID, m.ID, m.Author.Username); err != nil {
+						fmt.Println("Failed to pencil answer: ", err.Error())
+					}
+					return
+				}
+				pencilActionMatches := pencilActionRegex.FindStringSubmatch(m.Content)
+				if pencilActionMatches != nil {
+					if err := c.handlePencilAction(s, strings.ToLower(pencilActionMatches[1]), pencilActionMatches[2], m.ChannelID, m.ID, m.Author.Username); err != nil {
+						fmt.Println("Failed to handle pencil action: ", err.Error())
+					}
+					return
+				}
+
 				matches := answerRegex.FindStringSubmatch(m.Content)
 				if matches == nil || len(matches) != 3 {
 					return
 				}
+				if allowed, err := AllowGuess(s, c.guessLimiter, c.guessLimits(), crosswordStateFile, m.Author.Username, matches[1], m.ChannelID, m.ID); !allowed {
+					if err != nil {
+						fmt.Println("Failed to react to rate limited guess: ", err.Error())
+					}
+					return
+				}
 				if err := c.handleCheckWordSubmission(s, matches[1], matches[2], m.ChannelID, m.ID, m.Author.Username); err != nil {
 					fmt.Println("Failed to check work: ", err.Error())
 					return
@@ -122,30 +288,226 @@ func (c *Crossword) SubCommands() []*discordgo.ApplicationCommandOption {
 			Description: "Start the game (if available).",
 			Type:        discordgo.ApplicationCommandOptionSubCommand,
 		},
+		{
+			Name:        crosswordCmdAnswer,
+			Description: "Answer a clue.",
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "clue",
+					Description:  "The clue e.g. A3",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "answer",
+					Description: "Your answer",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        crosswordCmdExport,
+			Description: "Download the crossword to solve offline.",
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "format",
+					Description: "File format (default: svg)",
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Printable (SVG)", Value: "svg"},
+						{Name: "ipuz", Value: "ipuz"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "solution",
+					Description: "Include the solution (only once the game is complete)",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "show-solved",
+					Description: "Fill in the answers already solved (default: true)",
+				},
+			},
+		},
+		{
+			Name:        crosswordCmdSchedule,
+			Description: "Start the next queued crossword in this channel each day (admin only).",
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "time",
+					Description: "Time of day in UTC e.g. 09:00 or off to disable",
+					Required:    true,
+				},
+			},
+		},
 	}
 }
 
-func (c *Crossword) handleCheckWordSubmission(s *discordgo.Session, clueID string, word string, channelID string, messageID string, username string) error {
-	alreadySolved := false
-	correct := false
+func (c *Crossword) answerCrossword(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	var clueID, answer string
+	for _, o := range i.ApplicationCommandData().Options[0].Options[0].Options {
+		switch o.Name {
+		case "clue":
+			clueID = o.StringValue()
+		case "answer":
+			answer = o.StringValue()
+		}
+	}
+	return c.handleInteractionAnswer(s, i, clueID, answer)
+}
+
+func (c *Crossword) autocompleteClue(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	query := ""
+	for _, o := range i.ApplicationCommandData().Options[0].Options[0].Options {
+		if o.Name == "clue" && o.Focused {
+			query = strings.ToLower(strings.TrimSpace(o.StringValue()))
+		}
+	}
+	choices := []*discordgo.ApplicationCommandOptionChoice{}
+	if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+		for _, w := range cw.Unsolved() {
+			name := fmt.Sprintf("%s: %s (%s)", w.ClueID(), w.Word.Clue, w.Word.LetterCountStr())
+			if query != "" && !strings.Contains(strings.ToLower(name), query) {
+				continue
+			}
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: util.TrimToN(name, 100), Value: w.ClueID()})
+			// discord allows at most 25 choices
+			if len(choices) == 25 {
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	})
+}
+
+func (c *Crossword) openAnswerModal(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("%s:%s", c.Prefix(), crosswordAnswerModal),
+			Title:    "Answer a clue",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    crosswordAnswerModalClue,
+							Label:       "Clue",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "e.g. A3",
+							Required:    true,
+							MaxLength:   5,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID: crosswordAnswerModalAnswer,
+							Label:    "Answer",
+							Style:    discordgo.TextInputShort,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func (c *Crossword) submitAnswerModal(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	var clueID, answer string
+	for _, row := range i.ModalSubmitData().Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			input, ok := component.(*discordgo.TextInput)
+			if !ok {
+				continue
+			}
+			switch input.CustomID {
+			case crosswordAnswerModalClue:
+				clueID = input.Value
+			case crosswordAnswerModalAnswer:
+				answer = input.Value
+			}
+		}
+	}
+	return c.handleInteractionAnswer(s, i, clueID, answer)
+}
+
+// crosswordComponents are the buttons attached to the board.
+func (c *Crossword) crosswordComponents(cw *CrosswordState) []discordgo.MessageComponent {
+	if cw.Complete {
+		return []discordgo.MessageComponent{}
+	}
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Answer",
+					Emoji:    &discordgo.ComponentEmoji{Name: "✏️"},
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("%s:%s", c.Prefix(), crosswordAnswerModal),
+				},
+			},
+		},
+	}
+}
+
+// crosswordAnswerResult is the outcome of checking an answer to a clue.
+type crosswordAnswerResult struct {
+	correct       bool
+	alreadySolved bool
+	guessResult   util.GuessResult
+	closeFeedback bool
+}
+
+func (c *Crossword) checkAnswer(s *discordgo.Session, clueID string, word string, username string) (crosswordAnswerResult, error) {
+	result := crosswordAnswerResult{guessResult: util.GuessWrong}
+	var completedThreadID, completedMessage string
 	err := c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
+		result.closeFeedback = cw.Cfg.CloseFeedbackEnabled()
 		for k, w := range cw.Game.Words {
 			if w.ClueID() != strings.ToUpper(clueID) {
 				continue
 			}
-			if w.Solved {
-				alreadySolved = true
+			if w.Solved || cw.Complete {
+				result.alreadySolved = true
 				break
 			}
 			if strings.EqualFold(util.WithoutSpaces(word), w.Word.Word) {
-				correct = true
+				result.correct = true
 				solved := cw.Game.Words[k]
 				solved.Solved = true
 				cw.Game.Words[k] = solved
+				delete(cw.Pencil, w.ClueID())
 
 				cw.Scores.Add(username)
 				break
 			}
+			result.guessResult = util.DefaultMatcher().Classify(word, []string{spacedAnswer(w.Word)}, nil)
+			if result.guessResult == util.GuessCorrect {
+				// crossword answers must be exact so a roughly correct guess is only close.
+				result.guessResult = util.GuessClose
+			}
+			break
 		}
 		unsolved := 0
 		for _, w := range cw.Game.Words {
@@ -155,40 +517,466 @@ func (c *Crossword) handleCheckWordSubmission(s *discordgo.Session, clueID strin
 		}
 		if unsolved == 0 && !cw.Complete {
 			cw.Complete = true
-			if _, err := s.ChannelMessageSend(
-				cw.AnswerThreadID,
-				fmt.Sprintf("Game completed!\n\nScores:\n%s", cw.Scores.Render()),
-			); err != nil {
-				fmt.Println("Failed to send game completion message: ", err.Error())
-				// don't fail as it'll stop it writing the game complete flag etc.
-				return cw, nil
-			}
+			completedThreadID = cw.AnswerThreadID
+			completedMessage = fmt.Sprintf("Game completed!\n\nScores:\n%s", cw.Scores.Render())
 		}
 		return cw, nil
 	})
+	if err != nil {
+		return result, err
+	}
+	// the message is sent after the game is written so other guesses are not blocked by discord.
+	if completedMessage != "" {
+		if _, err := s.ChannelMessageSend(completedThreadID, completedMessage); err != nil {
+			// don't fail since the game was still completed.
+			fmt.Println("Failed to send game completion message: ", err.Error())
+		}
+	}
+	if result.correct {
+		return result, c.refreshCrossword(s)
+	}
+	return result, nil
+}
+
+func (c *Crossword) handleCheckWordSubmission(s *discordgo.Session, clueID string, word string, channelID string, messageID string, username string) error {
+	result, err := c.checkAnswer(s, clueID, word, username)
 	if err != nil {
 		return err
 	}
+	if result.correct {
+		return s.MessageReactionAdd(channelID, messageID, "✅")
+	}
+	if result.alreadySolved {
+		return s.MessageReactionAdd(channelID, messageID, "🕣")
+	}
+	return s.MessageReactionAdd(channelID, messageID, GuessReaction(result.guessResult, result.closeFeedback))
+}
 
-	if correct {
-		if err := s.MessageReactionAdd(channelID, messageID, "✅"); err != nil {
-			return err
+// handleInteractionAnswer checks an answer submitted via the slash command or modal and responds ephemerally.
+func (c *Crossword) handleInteractionAnswer(s *discordgo.Session, i *discordgo.InteractionCreate, clueID string, word string) error {
+	clueID = strings.ToUpper(strings.TrimSpace(clueID))
+	word = strings.TrimSpace(word)
+	username := interactionUsername(i)
+
+	var answerThreadID string
+	var guessLimits *ratelimit.Override
+	if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+		answerThreadID = cw.AnswerThreadID
+		guessLimits = cw.Cfg.GuessLimitOverride()
+		for _, w := range cw.Game.Words {
+			if w.ClueID() == clueID {
+				return nil
+			}
 		}
-		return c.refreshCrossword(s)
-	} else {
-		if alreadySolved {
-			if err := s.MessageReactionAdd(channelID, messageID, "🕣"); err != nil {
-				return err
+		return fmt.Errorf("unknown clue: %s", clueID)
+	}); err != nil {
+		return err
+	}
+	if answerThreadID == "" {
+		return errors.New("game has not been started")
+	}
+
+	if !c.guessLimiter.AllowOverride(crosswordStateFile, username, clueID, guessLimits) {
+		return respondEphemeral(s, i, fmt.Sprintf("%s You are guessing too quickly, try again later.", rateLimitedReaction))
+	}
+
+	// checking a correct answer redraws the board which may take longer than discord waits for a response
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		return err
+	}
+	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: util.ToPtr(c.interactionAnswerReply(s, answerThreadID, clueID, word, username)),
+	})
+	return err
+}
+
+// interactionAnswerReply checks the answer and returns the reply to show the user.
+func (c *Crossword) interactionAnswerReply(s *discordgo.Session, answerThreadID string, clueID string, word string, username string) string {
+	result, err := c.checkAnswer(s, clueID, word, username)
+	if err != nil {
+		fmt.Println("Failed to check answer: ", err.Error())
+		return fmt.Sprintf("Failed to check answer: %s", err.Error())
+	}
+	if result.correct {
+		if _, err := s.ChannelMessageSend(answerThreadID, fmt.Sprintf("%s solved %s", username, clueID)); err != nil {
+			fmt.Println("Failed to announce answer: ", err.Error())
+		}
+		return fmt.Sprintf("✅ %s is correct!", clueID)
+	}
+	if result.alreadySolved {
+		return fmt.Sprintf("🕣 %s has already been solved.", clueID)
+	}
+	feedback := ""
+	if result.closeFeedback {
+		switch result.guessResult {
+		case util.GuessClose:
+			feedback = " (but it was close)"
+		case util.GuessPartial:
+			feedback = " (but some of it was right)"
+		}
+	}
+	return fmt.Sprintf("%s %s is not the answer to %s%s.", GuessReaction(result.guessResult, result.closeFeedback), word, clueID, feedback)
+}
+
+func (c *Crossword) handleRequestHint(s *discordgo.Session, clueID string, channelID string, messageID string, username string) error {
+	found := false
+	hintGiven := false
+	err := c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
+		for k, w := range cw.Game.Words {
+			if w.ClueID() != strings.ToUpper(clueID) {
+				continue
+			}
+			found = true
+			if !cw.Cfg.HintsEnabled() || cw.Complete || w.Solved || len(w.Word.CharacterHints) >= cw.Cfg.MaxHintsPerClue {
+				return nil, nil
+			}
+			if last, ok := cw.LastHint[w.ClueID()]; ok && time.Since(last) < cw.Cfg.HintCooldown {
+				return nil, nil
+			}
+			candidates := hintCandidates(cw.Game, w)
+			if len(candidates) == 0 {
+				return nil, nil
+			}
+			cw.Game.Words[k].Word.CharacterHints = append(w.Word.CharacterHints, candidates[rand.Intn(len(candidates))])
+			if cw.LastHint == nil {
+				cw.LastHint = make(map[string]time.Time)
 			}
+			cw.LastHint[w.ClueID()] = time.Now()
+			cw.Scores.AddHint(username, cw.Cfg.HintPenalty)
+			hintGiven = true
+			return cw, nil
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return s.MessageReactionAdd(channelID, messageID, "🤷")
+	}
+	if !hintGiven {
+		return s.MessageReactionAdd(channelID, messageID, "👎")
+	}
+	if err := s.MessageReactionAdd(channelID, messageID, "💡"); err != nil {
+		return err
+	}
+	return c.refreshCrossword(s)
+}
+
+// hintCandidates returns the indexes of the letters in the placement that could be revealed by a hint.
+// Letters that are already visible are excluded, as are letters at crossings where the grid cell's
+// character index belongs to the other word since the renderer would reveal the wrong cell.
+func hintCandidates(game *crossword.Crossword, pl crossword.Placement) []int {
+	ambiguous := map[int]bool{}
+	revealed := map[int]bool{}
+	for i := range len(pl.Word.Word) {
+		x, y := pl.X, pl.Y
+		if pl.Vertical {
+			y += i
 		} else {
-			if err := s.MessageReactionAdd(channelID, messageID, "❌"); err != nil {
-				return err
+			x += i
+		}
+		cell := game.Grid[y][x]
+		if cell.CharIdx != i {
+			ambiguous[i] = true
+			ambiguous[cell.CharIdx] = true
+		}
+		if cellRevealed(game, x, y) {
+			revealed[i] = true
+		}
+	}
+	candidates := []int{}
+	for i := range len(pl.Word.Word) {
+		if !ambiguous[i] && !revealed[i] {
+			candidates = append(candidates, i)
+		}
+	}
+	return candidates
+}
+
+func (c *Crossword) exportCrossword(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	format := "svg"
+	opts := puzzle.ExportOptions{ShowSolved: true}
+	for _, o := range i.ApplicationCommandData().Options[0].Options[0].Options {
+		switch o.Name {
+		case "format":
+			format = o.StringValue()
+		case "solution":
+			opts.IncludeSolution = o.BoolValue()
+		case "show-solved":
+			opts.ShowSolved = o.BoolValue()
+		}
+	}
+
+	export := &bytes.Buffer{}
+	if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+		if opts.IncludeSolution && !cw.Complete {
+			return errors.New("the solution can only be exported once the game is complete")
+		}
+		opts.Title = util.IfEmpty(cw.ThreadTitle, "Crossword")
+		if format == "ipuz" {
+			return puzzle.WriteIPuz(export, cw.Game, opts)
+		}
+		return puzzle.WriteSVG(export, cw.Game, opts)
+	}); err != nil {
+		return err
+	}
+
+	contentType := "image/svg+xml"
+	if format == "ipuz" {
+		contentType = "application/json"
+	}
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+			Files: []*discordgo.File{
+				{
+					Name:        fmt.Sprintf("crossword.%s", format),
+					ContentType: contentType,
+					Reader:      export,
+				},
+			},
+		},
+	})
+}
+
+// cellRevealed returns true if the letter in the cell is shown on the board e.g. because a crossing
+// word has been solved.
+func cellRevealed(game *crossword.Crossword, x, y int) bool {
+	cell := game.Grid[y][x]
+	for _, pl := range game.CellPlacements(x, y) {
+		if pl.Solved || slices.Contains(pl.Word.CharacterHints, cell.CharIdx) {
+			return true
+		}
+	}
+	return false
+}
+
+// placementCell returns the grid position of the nth letter of the placement.
+func placementCell(pl crossword.Placement, n int) (int, int) {
+	if pl.Vertical {
+		return pl.X, pl.Y + n
+	}
+	return pl.X + n, pl.Y
+}
+
+func (c *Crossword) handlePencil(s *discordgo.Session, clueID string, answer string, channelID string, messageID string, username string) error {
+	reaction := "🤷"
+	err := c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
+		for _, w := range cw.Game.Words {
+			if w.ClueID() != strings.ToUpper(clueID) {
+				continue
+			}
+			if w.Solved || cw.Complete {
+				reaction = "🕣"
+				return nil, nil
+			}
+			letters := strings.ToUpper(util.WithoutSpaces(answer))
+			if len(letters) != len(w.Word.Word) {
+				reaction = "👎"
+				return nil, nil
+			}
+			// don't allow pencil marks that contradict letters already on the board.
+			for i := range len(letters) {
+				x, y := placementCell(w, i)
+				if cellRevealed(cw.Game, x, y) && byte(cw.Game.Grid[y][x].Char) != letters[i] {
+					reaction = "👎"
+					return nil, nil
+				}
+			}
+			if cw.Pencil == nil {
+				cw.Pencil = make(map[string]*PencilMark)
 			}
+			cw.Pencil[w.ClueID()] = &PencilMark{Answer: strings.ToUpper(strings.TrimSpace(answer)), User: username, At: time.Now()}
+			reaction = "✏️"
+			return cw, nil
 		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.MessageReactionAdd(channelID, messageID, reaction); err != nil {
+		return err
+	}
+	if reaction == "✏️" {
+		return c.refreshCrossword(s)
 	}
 	return nil
 }
 
+func (c *Crossword) handlePencilAction(s *discordgo.Session, action string, clueID string, channelID string, messageID string, username string) error {
+	clueID = strings.ToUpper(clueID)
+	var placement *crossword.Placement
+	var mark *PencilMark
+	var guessLimits *ratelimit.Override
+	pattern := ""
+	if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+		guessLimits = cw.Cfg.GuessLimitOverride()
+		for _, w := range cw.Game.Words {
+			if w.ClueID() == clueID {
+				placement = &w
+				mark = cw.Pencil[clueID]
+				pattern = crosswordPattern(cw, w)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if placement == nil {
+		return s.MessageReactionAdd(channelID, messageID, "🤷")
+	}
+
+	switch action {
+	case "pattern":
+		_, err := s.ChannelMessageSend(channelID, pattern)
+		return err
+	case "erase":
+		if mark == nil {
+			return s.MessageReactionAdd(channelID, messageID, "🤷")
+		}
+		if err := c.erasePencil(clueID); err != nil {
+			return err
+		}
+		if err := s.MessageReactionAdd(channelID, messageID, "🧽"); err != nil {
+			return err
+		}
+		return c.refreshCrossword(s)
+	case "confirm":
+		if mark == nil {
+			return s.MessageReactionAdd(channelID, messageID, "🤷")
+		}
+		if allowed, err := AllowGuess(s, c.guessLimiter, guessLimits, crosswordStateFile, username, clueID, channelID, messageID); !allowed {
+			return err
+		}
+		// the user that pencilled the answer gets the credit.
+		result, err := c.checkAnswer(s, clueID, mark.Answer, mark.User)
+		if err != nil {
+			return err
+		}
+		if result.correct {
+			return s.MessageReactionAdd(channelID, messageID, "✅")
+		}
+		if result.alreadySolved {
+			return s.MessageReactionAdd(channelID, messageID, "🕣")
+		}
+		if err := c.erasePencil(clueID); err != nil {
+			return err
+		}
+		if err := s.MessageReactionAdd(channelID, messageID, GuessReaction(result.guessResult, result.closeFeedback)); err != nil {
+			return err
+		}
+		return c.refreshCrossword(s)
+	}
+	return nil
+}
+
+func (c *Crossword) erasePencil(clueID string) error {
+	return c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
+		delete(cw.Pencil, clueID)
+		return cw, nil
+	})
+}
+
+// crosswordPattern describes the letters of a clue that are known e.g. A3: _ R _ _ S (5)
+func crosswordPattern(cw *CrosswordState, pl crossword.Placement) string {
+	letters := []string{}
+	for i := range len(pl.Word.Word) {
+		x, y := placementCell(pl, i)
+		if pl.Solved || cellRevealed(cw.Game, x, y) {
+			letters = append(letters, strings.ToUpper(cw.Game.Grid[y][x].String()))
+		} else {
+			letters = append(letters, "_")
+		}
+	}
+	// separate the words of multi-word answers
+	words := []string{}
+	offset := 0
+	for _, count := range pl.Word.LettersCounts {
+		if offset+count > len(letters) {
+			break
+		}
+		words = append(words, strings.Join(letters[offset:offset+count], " "))
+		offset += count
+	}
+	if offset != len(letters) {
+		words = []string{strings.Join(letters, " ")}
+	}
+
+	pattern := fmt.Sprintf("%s: %s (%s)", pl.ClueID(), strings.Join(words, " / "), pl.Word.LetterCountStr())
+	if mark, ok := cw.Pencil[pl.ClueID()]; ok {
+		pattern += fmt.Sprintf("\n:pencil2: %s (%s)", mark.Answer, mark.User)
+	}
+	return pattern
+}
+
+// drawPencilMarks draws the pencilled answers onto a board rendered with RenderCrossword. Where pencil
+// marks cross the most recent is shown.
+func drawPencilMarks(dc *gg.Context, cw *CrosswordState) {
+	if len(cw.Pencil) == 0 || cw.Complete {
+		return
+	}
+	type pencilledPlacement struct {
+		placement crossword.Placement
+		mark      *PencilMark
+	}
+	var pencilled []pencilledPlacement
+	for _, w := range cw.Game.Words {
+		if mark, ok := cw.Pencil[w.ClueID()]; ok && !w.Solved {
+			pencilled = append(pencilled, pencilledPlacement{placement: w, mark: mark})
+		}
+	}
+	slices.SortFunc(pencilled, func(a, b pencilledPlacement) int {
+		return a.mark.At.Compare(b.mark.At)
+	})
+
+	letters := map[[2]int]byte{}
+	for _, p := range pencilled {
+		answer := strings.ToUpper(util.WithoutSpaces(p.mark.Answer))
+		for i := range min(len(answer), len(p.placement.Word.Word)) {
+			x, y := placementCell(p.placement, i)
+			if !cellRevealed(cw.Game, x, y) {
+				letters[[2]int{x, y}] = answer[i]
+			}
+		}
+	}
+
+	offset, cellSize := crosswordGridGeometry(len(cw.Game.Grid))
+	dc.SetColor(pencilColor)
+	dc.SetFontFace(truetype.NewFace(pencilFont, &truetype.Options{Size: cellSize * 0.5}))
+	for pos, letter := range letters {
+		dc.DrawStringAnchored(
+			string(letter),
+			offset+float64(pos[0])*cellSize+cellSize/2,
+			offset+float64(pos[1])*cellSize+cellSize/2,
+			0.5,
+			0.5,
+		)
+	}
+}
+
+// spacedAnswer restores the spaces that were removed from multi-word answers when the crossword was generated.
+func spacedAnswer(w crossword.Word) string {
+	if len(w.LettersCounts) < 2 {
+		return w.Word
+	}
+	words := []string{}
+	offset := 0
+	for _, count := range w.LettersCounts {
+		if offset+count > len(w.Word) {
+			return w.Word
+		}
+		words = append(words, w.Word[offset:offset+count])
+		offset += count
+	}
+	return strings.Join(words, " ")
+}
+
 func (c *Crossword) refreshCrossword(s *discordgo.Session) error {
 	return c.openCrosswordForReading(func(cw *CrosswordState) error {
 
@@ -201,7 +989,8 @@ func (c *Crossword) refreshCrossword(s *discordgo.Session) error {
 			&discordgo.MessageEdit{
 				Channel:     cw.OriginalMessageChannel,
 				ID:          cw.OriginalMessageID,
-				Content:     util.ToPtr(threadText),
+				Content:     util.ToPtr(crosswordThreadText(cw)),
+				Components:  util.ToPtr(c.crosswordComponents(cw)),
 				Files:       files,
 				Attachments: util.ToPtr([]*discordgo.MessageAttachment{}),
 			},
@@ -211,6 +1000,17 @@ func (c *Crossword) refreshCrossword(s *discordgo.Session) error {
 }
 
 func (c *Crossword) startCrossword(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	if err := c.startGame(s, i.ChannelID); err != nil {
+		if errors.Is(err, errCrosswordAlreadyStarted) {
+			return respondEphemeral(s, i, "Game already started")
+		}
+		return err
+	}
+	return respondEphemeral(s, i, "Starting Game...")
+}
+
+// startGame posts the current crossword to the given channel and opens the answer thread.
+func (c *Crossword) startGame(s *discordgo.Session, channelID string) error {
 
 	var cw CrosswordState
 	err := c.openCrosswordForReading(func(c *CrosswordState) error {
@@ -222,22 +1022,37 @@ func (c *Crossword) startCrossword(s *discordgo.Session, i *discordgo.Interactio
 	}
 
 	if cw.AnswerThreadID != "" {
-		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{
-				Flags:   discordgo.MessageFlagsEphemeral,
-				Content: "Game already started",
-			},
-		})
+		return errCrosswordAlreadyStarted
+	}
+	if err := c.postGame(s, channelID, &cw); err != nil {
+		return err
 	}
+	if err := c.openCrosswordForWriting(func(stored *CrosswordState) (*CrosswordState, error) {
+		stored.AnswerThreadID = cw.AnswerThreadID
+		c.answerThreadID = cw.AnswerThreadID
 
-	files, err := c.renderBoard(&cw)
+		stored.OriginalMessageID = cw.OriginalMessageID
+		stored.OriginalMessageChannel = cw.OriginalMessageChannel
+		stored.StartedAt = cw.StartedAt
+		return stored, nil
+	}); err != nil {
+		fmt.Printf("Failed to store answer thread ID: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// postGame sends the board and creates the answer thread for the game then records them in the given state. The
+// state is not stored.
+func (c *Crossword) postGame(s *discordgo.Session, channelID string, cw *CrosswordState) error {
+	files, err := c.renderBoard(cw)
 	if err != nil {
 		return err
 	}
-	initialMessage, err := s.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
-		Content: threadText,
-		Files:   files,
+	initialMessage, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:    crosswordThreadText(cw),
+		Components: c.crosswordComponents(cw),
+		Files:      files,
 	})
 	if err != nil {
 		fmt.Printf("Failed to start game: %s\n", err.Error())
@@ -249,38 +1064,31 @@ func (c *Crossword) startCrossword(s *discordgo.Session, i *discordgo.Interactio
 		Type: discordgo.ChannelTypeGuildPublicThread,
 	})
 	if err != nil {
+		if err := s.ChannelMessageDelete(initialMessage.ChannelID, initialMessage.ID); err != nil {
+			fmt.Printf("Failed to delete initial message after failed game start: %s\n", err.Error())
+		}
 		return err
 	}
-	if err := c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
-		cw.AnswerThreadID = thread.ID
-		c.answerThreadID = thread.ID
-
-		cw.OriginalMessageID = initialMessage.ID
-		cw.OriginalMessageChannel = initialMessage.ChannelID
-
-		fmt.Printf("starting game. ThreadID: %s OriginalMessageID: %s OriginalMessageChannel: %s", cw.AnswerThreadID, cw.OriginalMessageID, cw.OriginalMessageChannel)
-		return cw, nil
-	}); err != nil {
-		fmt.Printf("Failed to store answer thread ID: %s\n", err.Error())
-		return err
-	}
+	cw.AnswerThreadID = thread.ID
+	cw.OriginalMessageID = initialMessage.ID
+	cw.OriginalMessageChannel = initialMessage.ChannelID
+	cw.StartedAt = time.Now()
 
-	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Flags:   discordgo.MessageFlagsEphemeral,
-			Content: "Starting Game...",
-		},
-	})
+	fmt.Printf("starting game. ThreadID: %s OriginalMessageID: %s OriginalMessageChannel: %s", cw.AnswerThreadID, cw.OriginalMessageID, cw.OriginalMessageChannel)
+	return nil
 }
 
 func (c *Crossword) renderBoard(cw *CrosswordState) ([]*discordgo.File, error) {
+	// once the game is over any unsolved answers are revealed.
 	canvas, err := RenderCrossword(
 		cw.Game,
+		crossword.WithAllSolved(cw.Complete),
 	)
 	if err != nil {
 		return nil, err
 	}
+	drawPencilMarks(canvas, cw)
+
 	board := &bytes.Buffer{}
 	if err := canvas.EncodePNG(board); err != nil {
 		return nil, err
@@ -296,11 +1104,117 @@ func (c *Crossword) renderBoard(cw *CrosswordState) ([]*discordgo.File, error) {
 
 }
 
+func (c *Crossword) start() {
+	minutely := time.NewTicker(time.Minute)
+	hourly := time.NewTicker(time.Hour)
+	defer minutely.Stop()
+	defer hourly.Stop()
+	for {
+		select {
+		case <-hourly.C:
+			timed := false
+			if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+				timed = cw.Cfg.Timed() && !cw.StartedAt.IsZero() && !cw.Complete
+				return nil
+			}); err != nil {
+				if !os.IsNotExist(err) {
+					fmt.Println("Failed hourly crossword check: ", err.Error())
+				}
+				continue
+			}
+			// keep the remaining time in the message up to date
+			if timed {
+				if err := c.refreshCrossword(c.globalSession); err != nil {
+					fmt.Println("Failed hourly crossword refresh: ", err.Error())
+				}
+			}
+		case <-minutely.C:
+			triggerCompletion := false
+			var reminderThreadID, reminder string
+			if err := c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
+				if !cw.Cfg.Timed() || cw.StartedAt.IsZero() || cw.Complete {
+					return nil, nil
+				}
+				if cw.TimeLeft() <= 0 {
+					triggerCompletion = true
+					return nil, nil
+				}
+				lastReminder := cw.LastReminder
+				if lastReminder.IsZero() {
+					lastReminder = cw.StartedAt
+				}
+				if cw.Cfg.ReminderInterval <= 0 || time.Since(lastReminder) < cw.Cfg.ReminderInterval {
+					return nil, nil
+				}
+				cw.LastReminder = time.Now()
+				reminderThreadID = cw.AnswerThreadID
+				reminder = fmt.Sprintf("%s remaining with %d clues unsolved.", cw.TimeLeft().Truncate(time.Minute).String(), len(cw.Unsolved()))
+				return cw, nil
+			}); err != nil && !os.IsNotExist(err) {
+				fmt.Println("Failed minutely crossword check: ", err.Error())
+			}
+			// sent after the game is written so guesses are not blocked by discord.
+			if reminder != "" {
+				if _, err := c.globalSession.ChannelMessageSend(reminderThreadID, reminder); err != nil {
+					fmt.Println("Failed to send crossword reminder: ", err.Error())
+				}
+			}
+			if triggerCompletion {
+				if err := c.completeCrossword(c.globalSession, "Ran out of time."); err != nil {
+					fmt.Println("Failed to complete crossword: ", err.Error())
+				}
+			}
+			if err := c.runSchedules(c.globalSession); err != nil {
+				fmt.Println("Failed to run crossword schedules: ", err.Error())
+			}
+		}
+	}
+}
+
+// completeCrossword ends the game revealing any unsolved answers.
+func (c *Crossword) completeCrossword(s *discordgo.Session, reason string) error {
+	var completedThreadID, completedMessage string
+	if err := c.openCrosswordForWriting(func(cw *CrosswordState) (*CrosswordState, error) {
+		if cw.Complete {
+			return nil, nil
+		}
+		cw.Complete = true
+
+		unsolved := &strings.Builder{}
+		for _, w := range cw.Unsolved() {
+			fmt.Fprintf(unsolved, "- %s: %s\n", w.ClueID(), strings.ToUpper(spacedAnswer(w.Word)))
+		}
+		completedThreadID = cw.AnswerThreadID
+		completedMessage = fmt.Sprintf("Game completed!\n%s\n\nUnsolved:\n%s\nScores:\n%s", reason, unsolved.String(), cw.Scores.Render())
+		return cw, nil
+	}); err != nil {
+		return err
+	}
+	if completedMessage != "" {
+		if _, err := s.ChannelMessageSend(completedThreadID, completedMessage); err != nil {
+			fmt.Println("Failed to send game completion message: ", err.Error())
+		}
+	}
+	return c.refreshCrossword(s)
+}
+
+// guessLimits returns the current game's replacement guess limits. The bot's limits are used if the game cannot be read.
+func (c *Crossword) guessLimits() *ratelimit.Override {
+	var override *ratelimit.Override
+	if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+		override = cw.Cfg.GuessLimitOverride()
+		return nil
+	}); err != nil {
+		return nil
+	}
+	return override
+}
+
 func (c *Crossword) openCrosswordForReading(cb func(cw *CrosswordState) error) error {
 	c.gameLock.RLock()
 	defer c.gameLock.RUnlock()
 
-	f, err := os.Open("var/crossword/game/current.json")
+	f, err := os.Open(crosswordStateFile)
 	if err != nil {
 		return err
 	}
@@ -315,6 +1229,9 @@ func (c *Crossword) openCrosswordForReading(cb func(cw *CrosswordState) error) e
 }
 
 func (c *Crossword) handleAdminAction(s *discordgo.Session, action string, guildID string, channelID string, messageID string) error {
+	if handled, err := HandleCooldownAdminAction(s, c.guessLimiter, action, channelID, messageID); handled {
+		return err
+	}
 	switch action {
 	case "refresh":
 		if err := c.refreshCrossword(s); err != nil {
@@ -347,10 +1264,17 @@ func (c *Crossword) handleAdminAction(s *discordgo.Session, action string, guild
 			for k := range cw.Game.Words {
 				solved := cw.Game.Words[k]
 				solved.Solved = false
+				solved.Word.CharacterHints = nil
 				cw.Game.Words[k] = solved
 				cw.Scores.Scores = make(map[string]*scores.Score)
 				cw.Scores.LastUser = ""
 				cw.Complete = false
+				cw.LastHint = nil
+				cw.Pencil = nil
+			}
+			if !cw.StartedAt.IsZero() {
+				cw.StartedAt = time.Now()
+				cw.LastReminder = time.Time{}
 			}
 			return cw, nil
 		}); err != nil {
@@ -369,7 +1293,7 @@ func (c *Crossword) openCrosswordForWriting(cb func(cw *CrosswordState) (*Crossw
 	c.gameLock.Lock()
 	defer c.gameLock.Unlock()
 
-	f, err := os.OpenFile("var/crossword/game/current.json", os.O_RDWR|os.O_EXCL, 0666)
+	f, err := os.OpenFile(crosswordStateFile, os.O_RDWR|os.O_EXCL, 0666)
 	if err != nil {
 		return err
 	}
@@ -400,16 +1324,29 @@ func (c *Crossword) openCrosswordForWriting(cb func(cw *CrosswordState) (*Crossw
 	return enc.Encode(cw)
 }
 
+const (
+	crosswordRenderSize      = 1500
+	crosswordRenderBorder    = 25
+	crosswordRenderClueRatio = 0.4
+)
+
+// crosswordGridGeometry returns the offset of the grid and the size of each cell when rendered
+// by RenderCrossword, so other things can be drawn onto the board.
+func crosswordGridGeometry(gridSize int) (float64, float64) {
+	gridWidth := (crosswordRenderSize - 3*crosswordRenderBorder) * (1 - crosswordRenderClueRatio)
+	return crosswordRenderBorder, gridWidth / float64(gridSize)
+}
+
 func RenderCrossword(c *crossword.Crossword, extraOpts ...crossword.RenderOption) (*gg.Context, error) {
 	return crossword.RenderPNG(
 		c,
-		1500,
-		1500,
+		crosswordRenderSize,
+		crosswordRenderSize,
 		append(
 			[]crossword.RenderOption{
 				crossword.WithClues(true),
-				crossword.WithBorder(25),
-				crossword.WithClueRatio(0.4),
+				crossword.WithBorder(crosswordRenderBorder),
+				crossword.WithClueRatio(crosswordRenderClueRatio),
 			},
 			extraOpts...,
 		)...,
@@ -0,0 +1,263 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/gamesmaster/pkg/queue"
+)
+
+const (
+	CrosswordQueueDir    = "var/crossword/queue"
+	crosswordScheduleDir = "var/crossword/schedule"
+	crosswordStateFile   = "var/crossword/game/current.json"
+)
+
+var errCrosswordAlreadyStarted = errors.New("game already started")
+
+// CrosswordSchedule starts the next queued crossword for a guild at the same time each day. Only one crossword
+// can run at a time, so starting a scheduled game completes the previous one and only one guild can have a
+// schedule.
+type CrosswordSchedule struct {
+	GuildID   string
+	ChannelID string
+	// StartAt is the time of day in UTC e.g. 09:00
+	StartAt     string
+	LastStarted time.Time
+}
+
+// Due returns true if the start time has passed today and no game has been started since.
+func (s *CrosswordSchedule) Due(now time.Time) (bool, error) {
+	startAt, err := time.Parse("15:04", s.StartAt)
+	if err != nil {
+		return false, err
+	}
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), startAt.Hour(), startAt.Minute(), 0, 0, time.UTC)
+	return !now.Before(today) && s.LastStarted.Before(today), nil
+}
+
+// CrosswordQueue returns the queue of crosswords waiting to be started in the given guild.
+func CrosswordQueue(queueDir string, guildID string) *queue.Dir {
+	return queue.NewDir(path.Join(queueDir, path.Base(guildID)))
+}
+
+func (c *Crossword) scheduleCrossword(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	if interactionUsername(i) != ".warmans" {
+		return respondEphemeral(s, i, "Only admins can schedule crosswords.")
+	}
+	if i.GuildID == "" {
+		return respondEphemeral(s, i, "Crosswords can only be scheduled in a server.")
+	}
+	var startAt string
+	for _, o := range i.ApplicationCommandData().Options[0].Options[0].Options {
+		if o.Name == "time" {
+			startAt = strings.TrimSpace(o.StringValue())
+		}
+	}
+	if strings.EqualFold(startAt, "off") {
+		if err := c.removeSchedule(i.GuildID); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return respondEphemeral(s, i, "Scheduled crosswords disabled.")
+	}
+	parsed, err := time.Parse("15:04", startAt)
+	if err != nil {
+		return respondEphemeral(s, i, fmt.Sprintf("Invalid time %s, expected HH:MM e.g. 09:00", startAt))
+	}
+	existing, err := c.listSchedules()
+	if err != nil {
+		return err
+	}
+	for _, v := range existing {
+		if v.GuildID != i.GuildID {
+			return respondEphemeral(s, i, "Crosswords are already scheduled in another server and only one crossword can run at a time.")
+		}
+	}
+
+	schedule := &CrosswordSchedule{
+		GuildID:   i.GuildID,
+		ChannelID: i.ChannelID,
+		StartAt:   parsed.Format("15:04"),
+		// don't start a game immediately if the time has already passed today.
+		LastStarted: time.Now(),
+	}
+	if err := c.writeSchedule(schedule); err != nil {
+		return err
+	}
+	queued, err := CrosswordQueue(CrosswordQueueDir, i.GuildID).Len()
+	if err != nil {
+		return err
+	}
+	return respondEphemeral(
+		s,
+		i,
+		fmt.Sprintf("The next queued crossword will start in this channel at %s UTC each day. %d crosswords queued.", schedule.StartAt, queued),
+	)
+}
+
+// runSchedules starts the next queued crossword for any schedules that are due.
+func (c *Crossword) runSchedules(s *discordgo.Session) error {
+	schedules, err := c.listSchedules()
+	if err != nil {
+		return err
+	}
+	for k, schedule := range schedules {
+		if k > 0 && schedule.GuildID != schedules[0].GuildID {
+			// the game is shared by all guilds so a second schedule would replace the first guild's game.
+			fmt.Printf("Ignoring crossword schedule for guild %s since guild %s already has one\n", schedule.GuildID, schedules[0].GuildID)
+			continue
+		}
+		due, err := schedule.Due(time.Now())
+		if err != nil {
+			fmt.Printf("Invalid crossword schedule for guild %s: %s\n", schedule.GuildID, err.Error())
+			continue
+		}
+		if !due {
+			continue
+		}
+		// don't try again until tomorrow unless the game fails to start.
+		lastStarted := schedule.LastStarted
+		schedule.LastStarted = time.Now()
+		if err := c.writeSchedule(schedule); err != nil {
+			return err
+		}
+		if err := c.startNextQueued(s, schedule); err != nil {
+			fmt.Printf("Failed to start scheduled crossword for guild %s: %s\n", schedule.GuildID, err.Error())
+			schedule.LastStarted = lastStarted
+			if err := c.writeSchedule(schedule); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// startNextQueued starts the crossword at the front of the guild's queue. The current game is only replaced, and
+// the queued game removed from the queue, once the new game has been posted so neither is lost if starting fails.
+func (c *Crossword) startNextQueued(s *discordgo.Session, schedule *CrosswordSchedule) error {
+	q := CrosswordQueue(CrosswordQueueDir, schedule.GuildID)
+	next := &CrosswordState{}
+	name, ok, err := q.Peek(next)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Printf("No crosswords queued for guild %s\n", schedule.GuildID)
+		return nil
+	}
+
+	previousRunning := false
+	if err := c.openCrosswordForReading(func(cw *CrosswordState) error {
+		previousRunning = cw.AnswerThreadID != "" && !cw.Complete
+		return nil
+	}); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := c.postGame(s, schedule.ChannelID, next); err != nil {
+		return err
+	}
+	if previousRunning {
+		// the new game is already posted so it should replace the previous game even if completing it failed.
+		if err := c.completeCrossword(s, "A new crossword is starting."); err != nil {
+			fmt.Println("Failed to complete previous crossword: ", err.Error())
+		}
+	}
+	if err := c.replaceCrossword(next); err != nil {
+		return err
+	}
+	return q.Remove(name)
+}
+
+// replaceCrossword overwrites the current game with the given state.
+func (c *Crossword) replaceCrossword(cw *CrosswordState) error {
+	c.gameLock.Lock()
+	defer c.gameLock.Unlock()
+
+	if err := os.MkdirAll(path.Dir(crosswordStateFile), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(crosswordStateFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	c.answerThreadID = cw.AnswerThreadID
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(cw)
+}
+
+func (c *Crossword) listSchedules() ([]*CrosswordSchedule, error) {
+	c.scheduleLock.Lock()
+	defer c.scheduleLock.Unlock()
+
+	entries, err := os.ReadDir(crosswordScheduleDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var schedules []*CrosswordSchedule
+	for _, v := range entries {
+		if v.IsDir() || path.Ext(v.Name()) != ".json" {
+			continue
+		}
+		schedule, err := readSchedule(path.Join(crosswordScheduleDir, v.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schedule %s: %w", v.Name(), err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+func readSchedule(filePath string) (*CrosswordSchedule, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	schedule := &CrosswordSchedule{}
+	return schedule, json.NewDecoder(f).Decode(schedule)
+}
+
+func (c *Crossword) writeSchedule(schedule *CrosswordSchedule) error {
+	c.scheduleLock.Lock()
+	defer c.scheduleLock.Unlock()
+
+	if err := os.MkdirAll(crosswordScheduleDir, 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(scheduleFile(schedule.GuildID))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(schedule)
+}
+
+func (c *Crossword) removeSchedule(guildID string) error {
+	c.scheduleLock.Lock()
+	defer c.scheduleLock.Unlock()
+
+	return os.Remove(scheduleFile(guildID))
+}
+
+func scheduleFile(guildID string) string {
+	return path.Join(crosswordScheduleDir, fmt.Sprintf("%s.json", path.Base(guildID)))
+}
@@ -0,0 +1,686 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/gamesmaster/pkg/discord"
+	"github.com/warmans/gamesmaster/pkg/picturequiz"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+)
+
+const (
+	PictureQuizCmdStart  string = "start"
+	PictureQuizCmdSubmit string = "submit"
+)
+
+// NewPictureQuizCommand creates the command for the given variant. Submitters are the usernames allowed to submit
+// games in addition to the admin (only used if the variant accepts submissions).
+func NewPictureQuizCommand(
+	logger *slog.Logger,
+	globalSession *discordgo.Session,
+	guessLimits ratelimit.Config,
+	variant *picturequiz.Variant,
+	submitters []string,
+) *PictureQuiz {
+	f := &PictureQuiz{
+		globalSession: globalSession,
+		logger:        logger,
+		guessLimiter:  ratelimit.NewLimiter(guessLimits),
+		variant:       variant,
+		submitters:    submitters,
+	}
+	go func() {
+		if err := f.start(); err != nil {
+			panic(err)
+		}
+	}()
+	return f
+}
+
+type PictureQuiz struct {
+	logger        *slog.Logger
+	globalSession *discordgo.Session
+	variant       *picturequiz.Variant
+	gameLock      sync.RWMutex
+	// answerThreadIDs are keyed by state file so variants with one shared game only have one entry.
+	answerThreadIDs sync.Map
+	guessLimiter    *ratelimit.Limiter
+	boardPages      BoardPages
+	submitters      []string
+	submissionLock  sync.Mutex
+	submissions     map[string]*pictureQuizSubmission
+}
+
+func (c *PictureQuiz) Prefix() string {
+	return c.variant.Name
+}
+
+func (c *PictureQuiz) RootCommand() string {
+	return c.variant.Name
+}
+
+func (c *PictureQuiz) Description() string {
+	return c.variant.Description
+}
+
+func (c *PictureQuiz) AutoCompleteHandlers() discord.InteractionHandlers {
+	return discord.InteractionHandlers{}
+}
+
+func (c *PictureQuiz) ButtonHandlers() discord.InteractionHandlers {
+	return discord.InteractionHandlers{}
+}
+
+func (c *PictureQuiz) ModalHandlers() discord.InteractionHandlers {
+	if !c.variant.Submissions {
+		return discord.InteractionHandlers{}
+	}
+	return discord.InteractionHandlers{
+		pictureQuizSubmitModal: c.submitGameAnswers,
+	}
+}
+
+func (c *PictureQuiz) CommandHandlers() discord.InteractionHandlers {
+	handlers := discord.InteractionHandlers{
+		PictureQuizCmdStart: c.startGame,
+	}
+	if c.variant.Submissions {
+		handlers[PictureQuizCmdSubmit] = c.submitGame
+	}
+	return handlers
+}
+
+func (c *PictureQuiz) SubCommands() []*discordgo.ApplicationCommandOption {
+	commands := []*discordgo.ApplicationCommandOption{
+		{
+			Name:        PictureQuizCmdStart,
+			Description: "Start the game (if available).",
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandOption{
+				StartDurationOption(),
+			},
+		},
+	}
+	if c.variant.Submissions {
+		commands = append(commands, &discordgo.ApplicationCommandOption{
+			Name:        PictureQuizCmdSubmit,
+			Description: "Submit images for a new game. You will be asked for the answers.",
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Options:     pictureQuizSubmissionOptions(),
+		})
+	}
+	return commands
+}
+
+func (c *PictureQuiz) MessageHandlers() discord.MessageHandlers {
+	return discord.MessageHandlers{
+		func(s *discordgo.Session, m *discordgo.MessageCreate) {
+			answerThreadID, err := c.answerThreadID(m.GuildID)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					c.logger.Error("Failed to get current answer thread ID", slog.String("game", c.variant.Name), slog.String("err", err.Error()))
+				}
+				return
+			}
+			if answerThreadID == "" || m.ChannelID != answerThreadID {
+				return
+			}
+
+			// is the message a request for a clue?
+			clueMatches := posterClueRegex.FindStringSubmatch(m.Content)
+			if clueMatches != nil || len(clueMatches) == 2 {
+				if err := c.handleRequestClue(s, m.GuildID, clueMatches[1], m.ChannelID, m.ID, m.Author.Username); err != nil {
+					c.logger.Error("Failed to get clue", slog.String("err", err.Error()))
+				}
+				return
+			}
+
+			// is the message an admin command?
+			if m.Author.Username == ".warmans" {
+				adminMatches := adminRegex.FindStringSubmatch(m.Content)
+				if adminMatches != nil || len(adminMatches) == 2 {
+					if err := c.handleAdminAction(s, adminMatches[1], m.GuildID, m.ChannelID, m.ID); err != nil {
+						c.logger.Error("Admin action failed", slog.String("err", err.Error()))
+					}
+					return
+				}
+			}
+
+			// is the message a guess?
+			guessMatches := posterGuessRegex.FindStringSubmatch(m.Content)
+			if guessMatches == nil || len(guessMatches) != 3 {
+				return
+			}
+			// crossword directions are not needed since every item has a single number
+			itemNumber := strings.TrimLeft(strings.ToUpper(guessMatches[1]), "AD")
+			snapshot, err := c.getGameSnapshot(m.GuildID)
+			if err != nil {
+				c.logger.Error("Failed to get game", slog.String("err", err.Error()))
+				return
+			}
+			if allowed, err := AllowGuess(s, c.guessLimiter, snapshot.Cfg.GuessLimitOverride(), c.variant.StateFile(m.GuildID), m.Author.Username, itemNumber, m.ChannelID, m.ID); !allowed {
+				if err != nil {
+					c.logger.Error("Failed to react to rate limited guess", slog.String("err", err.Error()))
+				}
+				return
+			}
+			if err := c.handleCheckWordSubmission(
+				s,
+				m.GuildID,
+				itemNumber,
+				guessMatches[2],
+				m.ChannelID,
+				m.ID,
+				m.Author.Username,
+			); err != nil {
+				c.logger.Error("Failed to check word", slog.String("err", err.Error()))
+				return
+			}
+		},
+	}
+}
+
+// answerThreadID returns the thread where answers are given for the guild's game. It is empty if the game has not
+// started.
+func (c *PictureQuiz) answerThreadID(guildID string) (string, error) {
+	key := c.variant.StateFile(guildID)
+	if threadID, ok := c.answerThreadIDs.Load(key); ok {
+		return threadID.(string), nil
+	}
+	var threadID string
+	if err := c.openGameForReading(guildID, func(cw picturequiz.State) error {
+		threadID = cw.AnswerThreadID
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	if threadID != "" {
+		c.answerThreadIDs.Store(key, threadID)
+	}
+	return threadID, nil
+}
+
+func (c *PictureQuiz) handleRequestClue(s *discordgo.Session, guildID string, clueID string, channelID string, messageID string, username string) error {
+	var clueText string
+	var answerThreadID string
+	if err := c.openGameForWriting(guildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+		answerThreadID = cw.AnswerThreadID
+		if item := cw.Item(clueID); item != nil {
+			if text, ok := cw.RequestClue(item, username); ok {
+				clueText = fmt.Sprintf("%s %s", clueID, text)
+			}
+		}
+		return cw, nil
+	}); err != nil {
+		return err
+	}
+	if clueText == "" {
+		return s.MessageReactionAdd(channelID, messageID, "👎")
+	}
+	if _, err := s.ChannelMessageSend(answerThreadID, clueText); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PictureQuiz) handleAdminAction(s *discordgo.Session, action string, guildID string, channelID string, messageID string) error {
+	if editMatches := adminEditAnswersRegex.FindStringSubmatch(action); editMatches != nil {
+		found := false
+		if err := c.openGameForWriting(guildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+			if item := cw.Item(editMatches[2]); item != nil {
+				item.Aliases, item.Rejected = editAnswers(editMatches[1], editMatches[3], item.Aliases, item.Rejected)
+				found = true
+			}
+			return cw, nil
+		}); err != nil {
+			return err
+		}
+		if !found {
+			return s.MessageReactionAdd(channelID, messageID, "🤷")
+		}
+		return s.MessageReactionAdd(channelID, messageID, "👍")
+	}
+	if handled, err := HandleCooldownAdminAction(s, c.guessLimiter, action, channelID, messageID); handled {
+		return err
+	}
+	if by, ok, err := ParseExtendAdminAction(action); ok {
+		if err != nil {
+			return s.MessageReactionAdd(channelID, messageID, "🤷")
+		}
+		var state picturequiz.State
+		if err := c.openGameForWriting(guildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+			cw.Extend(by)
+			state = *cw
+			return cw, nil
+		}); err != nil {
+			return err
+		}
+		if _, err := s.ChannelMessageSend(channelID, EndTimeMessage(state.TimeLeft())); err != nil {
+			return err
+		}
+		if state.StartedAt.IsZero() {
+			return nil
+		}
+		return c.refreshGameImage(s, state)
+	}
+	switch action {
+	case "refresh":
+		if err := c.openGameForReading(guildID, func(cw picturequiz.State) error {
+			return c.refreshGameImage(s, cw)
+		}); err != nil {
+			return err
+		}
+		return s.MessageReactionAdd(channelID, messageID, "👀")
+	case "complete":
+		return c.forceCompleteGame(guildID, "admin action")
+	default:
+		return s.MessageReactionAdd(channelID, messageID, "🤷")
+	}
+}
+
+func (c *PictureQuiz) handleCheckWordSubmission(
+	s *discordgo.Session,
+	guildID string,
+	clueID string,
+	word string,
+	channelID string,
+	messageID string,
+	userName string,
+) error {
+	var guessAllowed = true
+	var closeFeedback = false
+	var outcome picturequiz.GuessOutcome
+
+	if err := c.openGameForWriting(guildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+		if !c.variant.AllowGuess(cw, userName) {
+			guessAllowed = false
+			// return immediately if the guess isn't allowed
+			return cw, nil
+		}
+		closeFeedback = cw.Cfg.CloseFeedbackEnabled()
+		outcome = cw.Guess(clueID, userName, word)
+		return cw, nil
+	}); err != nil {
+		return err
+	}
+
+	if !guessAllowed {
+		return s.MessageReactionAdd(channelID, messageID, "🙅‍♂️")
+	}
+
+	if outcome.Correct || outcome.PartCorrect {
+		reaction := "✅"
+		if !outcome.Correct {
+			reaction = "☑️"
+		}
+		if err := s.MessageReactionAdd(channelID, messageID, reaction); err != nil {
+			return err
+		}
+		if err := c.openGameForReading(guildID, func(cw picturequiz.State) error {
+			return c.refreshGameImage(s, cw)
+		}); err != nil {
+			return err
+		}
+		if outcome.Complete {
+			return c.forceCompleteGame(guildID, "All items have been solved.")
+		}
+		return nil
+	}
+
+	if outcome.AlreadySolved {
+		if err := s.MessageReactionAdd(channelID, messageID, "🕣"); err != nil {
+			return err
+		}
+	} else {
+		if err := s.MessageReactionAdd(channelID, messageID, GuessReaction(outcome.Result, closeFeedback)); err != nil {
+			return err
+		}
+	}
+	if outcome.StageChanged {
+		return c.openGameForReading(guildID, func(cw picturequiz.State) error {
+			return c.refreshGameImage(s, cw)
+		})
+	}
+	return nil
+}
+
+func (c *PictureQuiz) refreshGameImage(s *discordgo.Session, cw picturequiz.State) error {
+	pages, err := c.renderBoard(cw)
+	if err != nil {
+		return err
+	}
+	return c.boardPages.Edit(
+		s,
+		cw.OriginalMessageChannel,
+		cw.OriginalMessageID,
+		cw.PageMessageIDs,
+		c.gameDescription(&cw),
+		pages,
+	)
+}
+
+func (c *PictureQuiz) startGame(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+
+	if c.variant.Submissions {
+		if err := c.startNextQueued(i.GuildID); err != nil {
+			return err
+		}
+	}
+
+	gameState, err := c.getGameSnapshot(i.GuildID)
+	if err != nil {
+		return err
+	}
+	if gameState.AnswerThreadID != "" {
+		return respondEphemeral(s, i, "Game already started")
+	}
+
+	duration, err := StartDuration(i)
+	if err != nil {
+		return respondEphemeral(s, i, err.Error())
+	}
+	// the description is rendered before the game is stored so use a copy to work out the end time
+	startedAt := time.Now()
+	gameState.Start(startedAt, duration)
+
+	pages, err := c.renderBoard(gameState)
+	if err != nil {
+		return err
+	}
+
+	initialMessage, err := s.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
+		Content: c.gameDescription(&gameState),
+		Files:   []*discordgo.File{pages[0].File()},
+	})
+	if err != nil {
+		c.logger.Error("Failed to start game", slog.String("err", err.Error()))
+		return err
+	}
+	c.boardPages.Uploaded(initialMessage.ID, pages[0])
+
+	thread, err := s.MessageThreadStartComplex(initialMessage.ChannelID, initialMessage.ID, &discordgo.ThreadStart{
+		Name: fmt.Sprintf("%s Answers", gameState.GameTitle),
+		Type: discordgo.ChannelTypeGuildPublicThread,
+	})
+	if err != nil {
+		if err := s.ChannelMessageDelete(initialMessage.ChannelID, initialMessage.ID); err != nil {
+			c.logger.Error("Failed to initial delete message after failed game start", slog.String("err", err.Error()))
+		}
+		return err
+	}
+	pageMessageIDs, err := c.boardPages.SendExtra(s, initialMessage.ChannelID, pages)
+	if err != nil {
+		// the game still works without the extra pages, they will be missing from the board
+		c.logger.Error("Failed to send board pages", slog.String("err", err.Error()))
+	}
+	if err := c.openGameForWriting(i.GuildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+		cw.AnswerThreadID = thread.ID
+		c.answerThreadIDs.Store(c.variant.StateFile(i.GuildID), thread.ID)
+
+		cw.Start(startedAt, duration)
+		cw.OriginalMessageID = initialMessage.ID
+		cw.OriginalMessageChannel = initialMessage.ChannelID
+		cw.PageMessageIDs = pageMessageIDs
+
+		c.logger.Info("Starting game...",
+			slog.String("game", c.variant.Name),
+			slog.String("thread_id", cw.AnswerThreadID),
+			slog.String("original_message_id", cw.OriginalMessageID),
+			slog.String("original_message_channel", cw.OriginalMessageChannel),
+		)
+		return cw, nil
+	}); err != nil {
+		c.logger.Error("Failed to store answer thread ID", slog.String("err", err.Error()))
+		return err
+	}
+
+	return respondEphemeral(s, i, "Starting Game...")
+}
+
+func (c *PictureQuiz) renderBoard(state picturequiz.State) ([]*BoardPage, error) {
+	pages, err := c.variant.Renderer.Render(c.variant.ImagesDir(), &state)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeBoard(c.variant.BoardName, pages, state.Cfg.BoardFileSize())
+}
+
+func (c *PictureQuiz) openGameForReading(guildID string, cb func(cw picturequiz.State) error) error {
+	c.gameLock.RLock()
+	defer c.gameLock.RUnlock()
+
+	cw, err := c.readGame(guildID)
+	if err != nil {
+		return err
+	}
+	return cb(cw)
+}
+
+// readGame loads the guild's game. The caller must hold the gameLock.
+func (c *PictureQuiz) readGame(guildID string) (picturequiz.State, error) {
+	f, err := os.Open(c.variant.StateFile(guildID))
+	if err != nil {
+		return picturequiz.State{}, err
+	}
+	defer f.Close()
+
+	cw := picturequiz.State{}
+	if err := json.NewDecoder(f).Decode(&cw); err != nil {
+		return picturequiz.State{}, err
+	}
+	c.variant.Prepare(&cw)
+
+	return cw, nil
+}
+
+func (c *PictureQuiz) openGameForWriting(guildID string, cb func(cw *picturequiz.State) (*picturequiz.State, error)) error {
+	c.gameLock.Lock()
+	defer c.gameLock.Unlock()
+
+	f, err := os.OpenFile(c.variant.StateFile(guildID), os.O_RDWR|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	cw := &picturequiz.State{}
+	if err := json.NewDecoder(f).Decode(cw); err != nil {
+		return err
+	}
+	c.variant.Prepare(cw)
+
+	cw, err = cb(cw)
+	if err != nil {
+		return err
+	}
+
+	if err := f.Truncate(0); err != nil {
+		return c.dumpState(cw, err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		return c.dumpState(cw, err)
+	}
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(cw); err != nil {
+		return c.dumpState(cw, err)
+	}
+	return nil
+}
+
+func (c *PictureQuiz) getGameSnapshot(guildID string) (picturequiz.State, error) {
+	var snapshot picturequiz.State
+	err := c.openGameForReading(guildID, func(cw picturequiz.State) error {
+		snapshot = cw
+		return nil
+	})
+	return snapshot, err
+}
+
+func (c *PictureQuiz) dumpState(cw *picturequiz.State, err error) error {
+	c.logger.Info("Dumping state...")
+	if encerr := json.NewEncoder(os.Stderr).Encode(cw); encerr != nil {
+		c.logger.Error("failed to dump state", slog.String("err", err.Error()))
+	}
+	return err
+}
+
+// activeGuildIDs returns the guilds with a game. Variants with one shared game return a single empty guild ID.
+func (c *PictureQuiz) activeGuildIDs() ([]string, error) {
+	if !c.variant.PerGuild {
+		return []string{""}, nil
+	}
+	entries, err := os.ReadDir(c.variant.GameDir())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0)
+	for _, v := range entries {
+		if v.IsDir() || !strings.HasSuffix(v.Name(), ".json") {
+			continue
+		}
+		out = append(out, strings.TrimSuffix(v.Name(), ".json"))
+	}
+	return out, nil
+}
+
+func (c *PictureQuiz) start() error {
+	minutely := time.NewTicker(time.Minute)
+	hourly := time.NewTicker(time.Hour)
+	defer minutely.Stop()
+	defer hourly.Stop()
+	for {
+		select {
+		case <-hourly.C:
+			activeGuilds, err := c.activeGuildIDs()
+			if err != nil {
+				c.logger.Error("Failed to get active guilds", slog.String("err", err.Error()))
+				continue
+			}
+			for _, guildID := range activeGuilds {
+				if err := c.openGameForReading(guildID, func(cw picturequiz.State) error {
+					if cw.StartedAt.IsZero() {
+						return nil
+					}
+					return c.refreshGameImage(c.globalSession, cw)
+				}); err != nil {
+					c.logger.Error("Failed hourly image refresh", slog.String("err", err.Error()))
+				}
+			}
+
+		case <-minutely.C:
+			activeGuilds, err := c.activeGuildIDs()
+			if err != nil {
+				c.logger.Error("Failed to get active guilds", slog.String("err", err.Error()))
+				continue
+			}
+			for _, guildID := range activeGuilds {
+				triggerCompletion := false
+				if err := c.openGameForReading(guildID, func(cw picturequiz.State) error {
+					triggerCompletion = cw.Expired() && cw.NumUnsolved() > 0
+					return nil
+				}); err != nil {
+					c.logger.Error("Failed minutely game check", slog.String("err", err.Error()))
+				}
+				if triggerCompletion {
+					if err := c.forceCompleteGame(guildID, "Ran out of time."); err != nil {
+						c.logger.Error("Failed to complete game", slog.String("err", err.Error()))
+					}
+				}
+				if err := c.revealNextStages(guildID); err != nil {
+					c.logger.Error("Failed to reveal next stages", slog.String("err", err.Error()))
+				}
+			}
+		}
+	}
+}
+
+// revealNextStages re-renders the board if any items have reached their next reveal stage.
+func (c *PictureQuiz) revealNextStages(guildID string) error {
+	snapshot, err := c.getGameSnapshot(guildID)
+	if err != nil || !snapshot.AdvanceStages() {
+		return err
+	}
+	var state picturequiz.State
+	if err := c.openGameForWriting(guildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+		cw.AdvanceStages()
+		state = *cw
+		return cw, nil
+	}); err != nil {
+		return err
+	}
+	return c.refreshGameImage(c.globalSession, state)
+}
+
+func (c *PictureQuiz) forceCompleteGame(guildID, reason string) error {
+	state := picturequiz.State{}
+	if err := c.openGameForWriting(guildID, func(cw *picturequiz.State) (*picturequiz.State, error) {
+		cw.Reveal()
+		state = *cw
+		return cw, nil
+	}); err != nil {
+		return err
+	}
+	if _, err := c.globalSession.ChannelMessageSend(
+		state.AnswerThreadID,
+		fmt.Sprintf("Game completed in %s!\n%s\n\nScores:\n%s", time.Since(state.StartedAt).Truncate(time.Minute), reason, state.Scores.Render()),
+	); err != nil {
+		return err
+	}
+	return c.refreshGameImage(c.globalSession, state)
+}
+
+func (c *PictureQuiz) gameDescription(state *picturequiz.State) string {
+	clueText := ""
+	clueReactionText := ""
+	if ladder := state.Cfg.ClueLadder; len(ladder) > 0 {
+		clueText = fmt.Sprintf("- `clue` e.g. `clue 1` - get a clue about the panel. Clues unlock in order: %s. \n", ladder.String())
+		clueReactionText = "- :thumbsdown: if clues are not yet enabled. \n"
+	}
+	closeFeedbackText := ""
+	if state.Cfg.CloseFeedbackEnabled() {
+		closeFeedbackText = "- :pinching_hand: if your guess was close. \n" +
+			"- :jigsaw: if some of the words in your guess were right. \n"
+	}
+	extraRulesText := ""
+	for _, r := range c.variant.GameRules(state) {
+		if text := r.Description(); text != "" {
+			extraRulesText += fmt.Sprintf(" - %s\n", text)
+		}
+	}
+	if extraRulesText != "" {
+		extraRulesText = "\nExtra rules: \n" + extraRulesText
+	}
+	if state.Host != "" {
+		extraRulesText += fmt.Sprintf("\nThis game is hosted by %s, who cannot guess.\n", state.Host)
+	}
+	return fmt.Sprintf(
+		"Guess the %s by adding a message to the attached thread: \n"+
+			"- `guess` e.g. `guess 1 fargo` - submit an answer. \n"+
+			"%s\n"+
+			"The bot will respond with:\n"+
+			"- :x: if you guess incorrectly. \n"+
+			"%s"+
+			"- :white_check_mark: if you guess correctly. \n"+
+			"- :ballot_box_with_check: if you guess one part of an answer with several parts e.g. the artist. \n"+
+			"- :clock1: if someone has already guessed the item. \n"+
+			"- :man_gesturing_no: if your guess was not allowed. \n"+
+			"- :hourglass: if you are guessing too quickly (try again later). \n"+
+			"%s\n"+
+			"You have %s remaining to complete the puzzle.\n%s",
+		c.variant.Subject,
+		clueText,
+		closeFeedbackText,
+		clueReactionText,
+		state.TimeLeft().Truncate(time.Minute).String(),
+		extraRulesText,
+	)
+}
@@ -0,0 +1,334 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/gamesmaster/pkg/picturequiz"
+	"github.com/warmans/gamesmaster/pkg/queue"
+	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/gamesmaster/pkg/util"
+)
+
+const (
+	// pictureQuizSubmissionsDir is relative to the images dir so submitted images can be rendered like any other.
+	pictureQuizSubmissionsDir = "submissions"
+
+	pictureQuizSubmitModal        = "submit"
+	pictureQuizSubmitModalAnswers = "answers"
+
+	pictureQuizMaxSubmittedImages = 10
+	pictureQuizMaxImageBytes      = 10 * 1024 * 1024
+	// pictureQuizSubmissionTimeout is how long the submitter has to fill in the answers.
+	pictureQuizSubmissionTimeout = time.Minute * 15
+)
+
+// PictureQuizQueue returns the queue of submitted games waiting to be started in the given guild.
+func PictureQuizQueue(queueDir string, guildID string) *queue.Dir {
+	return queue.NewDir(path.Join(queueDir, path.Base(guildID)))
+}
+
+// pictureQuizSubmission holds the uploaded images until the submitter has given the answers.
+type pictureQuizSubmission struct {
+	GuildID   string
+	Host      string
+	Name      string
+	Images    []*discordgo.MessageAttachment
+	CreatedAt time.Time
+}
+
+func pictureQuizSubmissionOptions() []*discordgo.ApplicationCommandOption {
+	options := []*discordgo.ApplicationCommandOption{
+		{
+			Name:        "name",
+			Description: "Name of the game.",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    true,
+		},
+	}
+	for k := range pictureQuizMaxSubmittedImages {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Name:        fmt.Sprintf("image-%d", k+1),
+			Description: fmt.Sprintf("Image %d (PNG or JPEG).", k+1),
+			Type:        discordgo.ApplicationCommandOptionAttachment,
+			Required:    k == 0,
+		})
+	}
+	return options
+}
+
+func (c *PictureQuiz) canSubmit(username string) bool {
+	return username == ".warmans" || slices.Contains(c.submitters, username)
+}
+
+func (c *PictureQuiz) submitGame(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	username := interactionUsername(i)
+	if !c.canSubmit(username) {
+		return respondEphemeral(s, i, "You are not allowed to submit games.")
+	}
+	if i.GuildID == "" {
+		return respondEphemeral(s, i, "Games can only be submitted in a server.")
+	}
+
+	submission := &pictureQuizSubmission{GuildID: i.GuildID, Host: username, CreatedAt: time.Now()}
+	for _, o := range i.ApplicationCommandData().Options[0].Options[0].Options {
+		if o.Name == "name" {
+			submission.Name = strings.TrimSpace(o.StringValue())
+			continue
+		}
+		if o.Type != discordgo.ApplicationCommandOptionAttachment {
+			continue
+		}
+		attachment, ok := i.ApplicationCommandData().Resolved.Attachments[fmt.Sprintf("%v", o.Value)]
+		if !ok {
+			return respondEphemeral(s, i, fmt.Sprintf("Missing attachment for %s.", o.Name))
+		}
+		if imageExtension(attachment.ContentType) == "" {
+			return respondEphemeral(s, i, fmt.Sprintf("%s is not a PNG or JPEG image.", attachment.Filename))
+		}
+		if attachment.Size > pictureQuizMaxImageBytes {
+			return respondEphemeral(s, i, fmt.Sprintf("%s is too large, images must be under %dMB.", attachment.Filename, pictureQuizMaxImageBytes/1024/1024))
+		}
+		submission.Images = append(submission.Images, attachment)
+	}
+	if submission.Name == "" || len(submission.Images) == 0 {
+		return respondEphemeral(s, i, "A name and at least one image are required.")
+	}
+
+	c.submissionLock.Lock()
+	if c.submissions == nil {
+		c.submissions = map[string]*pictureQuizSubmission{}
+	}
+	c.submissions[submissionKey(i.GuildID, username)] = submission
+	c.submissionLock.Unlock()
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("%s:%s", c.Prefix(), pictureQuizSubmitModal),
+			Title:    fmt.Sprintf("%s answers", c.variant.Description),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    pictureQuizSubmitModalAnswers,
+							Label:       fmt.Sprintf("Answers for the %d images, one per line", len(submission.Images)),
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: "fargo | fargo the film\nthe thing",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func (c *PictureQuiz) submitGameAnswers(s *discordgo.Session, i *discordgo.InteractionCreate) error {
+	username := interactionUsername(i)
+
+	c.submissionLock.Lock()
+	submission := c.submissions[submissionKey(i.GuildID, username)]
+	delete(c.submissions, submissionKey(i.GuildID, username))
+	c.submissionLock.Unlock()
+
+	if submission == nil || time.Since(submission.CreatedAt) > pictureQuizSubmissionTimeout {
+		return respondEphemeral(s, i, "No images are waiting for answers, use the submit command to upload them again.")
+	}
+
+	var rawAnswers string
+	for _, row := range i.ModalSubmitData().Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			if input, ok := component.(*discordgo.TextInput); ok && input.CustomID == pictureQuizSubmitModalAnswers {
+				rawAnswers = input.Value
+			}
+		}
+	}
+	answers := parseSubmittedAnswers(rawAnswers)
+	if len(answers) != len(submission.Images) {
+		return respondEphemeral(
+			s,
+			i,
+			fmt.Sprintf("Expected %d answers but got %d, use the submit command to try again.", len(submission.Images), len(answers)),
+		)
+	}
+
+	// downloading the images may take longer than discord waits for a response
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		return err
+	}
+
+	result := ""
+	state, err := c.createSubmittedState(submission, answers)
+	if err == nil {
+		_, err = PictureQuizQueue(c.variant.QueueDir(), submission.GuildID).Push(submission.Name, state)
+	}
+	if err != nil {
+		c.logger.Error("Failed to queue submitted game", slog.String("err", err.Error()))
+		result = fmt.Sprintf("Failed to queue the game: %s", err.Error())
+	} else {
+		queued, err := PictureQuizQueue(c.variant.QueueDir(), submission.GuildID).Len()
+		if err != nil {
+			return err
+		}
+		result = fmt.Sprintf(
+			"Queued %s with %d images (%d games queued). It will be used the next time a game is started after the current one has finished.",
+			submission.Name,
+			len(state.Posters),
+			queued,
+		)
+	}
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: util.ToPtr(result)})
+	return err
+}
+
+// createSubmittedState downloads the images into the images dir and creates a game with the default config.
+func (c *PictureQuiz) createSubmittedState(submission *pictureQuizSubmission, answers [][]string) (*picturequiz.State, error) {
+	submissionDir := path.Join(
+		pictureQuizSubmissionsDir,
+		path.Base(submission.GuildID),
+		fmt.Sprintf("%d", submission.CreatedAt.UnixNano()),
+	)
+	if err := os.MkdirAll(path.Join(c.variant.ImagesDir(), submissionDir), 0755); err != nil {
+		return nil, err
+	}
+
+	state := &picturequiz.State{
+		GameTitle: submission.Name,
+		GuildID:   submission.GuildID,
+		Host:      submission.Host,
+		Posters:   make([]*picturequiz.Item, 0, len(submission.Images)),
+		Scores:    scores.NewTiered(len(submission.Images)),
+	}
+	c.variant.Prepare(state)
+	for k, attachment := range submission.Images {
+		imagePath := path.Join(submissionDir, fmt.Sprintf("%d%s", k+1, imageExtension(attachment.ContentType)))
+		if err := downloadImage(attachment.URL, path.Join(c.variant.ImagesDir(), imagePath)); err != nil {
+			return nil, fmt.Errorf("%s: %w", attachment.Filename, err)
+		}
+		state.Posters = append(state.Posters, &picturequiz.Item{
+			OriginalImage: imagePath,
+			Answer:        answers[k][0],
+			Aliases:       answers[k][1:],
+		})
+	}
+	return state, nil
+}
+
+// startNextQueued replaces the current game with the next queued game if there is no current game or it has
+// finished. The lock is held throughout so concurrent starts cannot both take a game from the queue, and the
+// queued game is only removed once it has replaced the current game.
+func (c *PictureQuiz) startNextQueued(guildID string) error {
+	c.gameLock.Lock()
+	defer c.gameLock.Unlock()
+
+	current, err := c.readGame(guildID)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil && !current.Complete() {
+		return nil
+	}
+	q := PictureQuizQueue(c.variant.QueueDir(), guildID)
+	next := &picturequiz.State{}
+	name, ok, err := q.Peek(next)
+	if err != nil || !ok {
+		return err
+	}
+	if err := c.replaceGame(guildID, next); err != nil {
+		return err
+	}
+	return q.Remove(name)
+}
+
+// replaceGame overwrites the guild's game with the given state. The caller must hold the gameLock.
+func (c *PictureQuiz) replaceGame(guildID string, state *picturequiz.State) error {
+	if err := os.MkdirAll(c.variant.GameDir(), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(c.variant.StateFile(guildID))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	c.answerThreadIDs.Delete(c.variant.StateFile(guildID))
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(state)
+}
+
+func submissionKey(guildID string, username string) string {
+	return fmt.Sprintf("%s:%s", guildID, username)
+}
+
+// parseSubmittedAnswers reads one answer per line. Each line may also have aliases separated by | e.g.
+// "fargo | fargo the film".
+func parseSubmittedAnswers(raw string) [][]string {
+	var answers [][]string
+	for _, line := range strings.Split(raw, "\n") {
+		var names []string
+		for _, name := range strings.Split(line, "|") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			answers = append(answers, names)
+		}
+	}
+	return answers
+}
+
+func imageExtension(contentType string) string {
+	switch contentType {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	}
+	return ""
+}
+
+// downloadImage saves the image at the URL to the given path after checking it can be decoded.
+func downloadImage(url string, dst string) error {
+	client := &http.Client{Timeout: time.Second * 30}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed with status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, pictureQuizMaxImageBytes+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > pictureQuizMaxImageBytes {
+		return fmt.Errorf("image is larger than %d bytes", pictureQuizMaxImageBytes)
+	}
+	if _, _, err := image.DecodeConfig(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("not a valid image: %w", err)
+	}
+	return os.WriteFile(dst, data, 0644)
+}
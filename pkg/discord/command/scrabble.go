@@ -1,12 +1,13 @@
 package command
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/gamesmaster/pkg/dictionary"
 	"github.com/warmans/gamesmaster/pkg/discord"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
 	"github.com/warmans/gamesmaster/pkg/util"
 	"github.com/warmans/go-scrabble"
 	"os"
@@ -43,7 +44,6 @@ __NOTES__
 `
 
 var submissionRegex = regexp.MustCompile(`([AD][0-9]+)\s([a-zA-Z]+)`)
-var validLetters = regexp.MustCompile(`^[A-Za-z]+$`)
 
 type ScrabbleState struct {
 	OriginalMessageID      string
@@ -61,24 +61,12 @@ const (
 	scrabbleCmdStart string = "start"
 )
 
-func NewScrabbleCommand(globalSession *discordgo.Session, wordsFilePath string) (*Scrabble, error) {
-	words, err := os.Open(wordsFilePath)
+func NewScrabbleCommand(globalSession *discordgo.Session, wordsFilePath string, guessLimits ratelimit.Config) (*Scrabble, error) {
+	dict, err := dictionary.LoadWords(wordsFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open words file: %w", err)
+		return nil, err
 	}
-	defer words.Close()
-
-	dict := make(map[string]struct{})
-	scanner := bufio.NewScanner(words)
-	for scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			return nil, fmt.Errorf("failed to scan dictionary: %w", err)
-		}
-		if validLetters.Match(scanner.Bytes()) {
-			dict[strings.ToUpper(scanner.Text())] = struct{}{}
-		}
-	}
-	sc := &Scrabble{globalSession: globalSession, dict: dict}
+	sc := &Scrabble{globalSession: globalSession, dict: dict, guessLimiter: ratelimit.NewLimiter(guessLimits)}
 	go sc.resumeBackgroundTasks()
 	return sc, nil
 }
@@ -87,8 +75,9 @@ type Scrabble struct {
 	gameLock       sync.RWMutex
 	answerThreadID string
 	globalSession  *discordgo.Session
-	dict           map[string]struct{}
+	dict           dictionary.Words
 	lastWordError  string
+	guessLimiter   *ratelimit.Limiter
 }
 
 func (c *Scrabble) Prefix() string {
@@ -164,6 +153,12 @@ func (c *Scrabble) MessageHandlers() discord.MessageHandlers {
 				if matches == nil || len(matches) != 3 {
 					return
 				}
+				if allowed, err := AllowGuess(s, c.guessLimiter, nil, m.GuildID, m.Author.Username, "", m.ChannelID, m.ID); !allowed {
+					if err != nil {
+						fmt.Println("Failed to react to rate limited submission: ", err.Error())
+					}
+					return
+				}
 
 				if err := c.handleCheckWordSubmission(
 					s,
@@ -256,6 +251,22 @@ func (c *Scrabble) handleTextCommand(s *discordgo.Session, command string, m *di
 			return false, err
 		}
 		return true, c.refreshGameImage(s, m.GuildID)
+	case ":cooldowns":
+		if m.Author.Username != ".warmans" {
+			return false, nil
+		}
+		return true, c.sendThreadMessage(m.GuildID, renderCooldowns(c.guessLimiter.Cooldowns()))
+	}
+
+	if strings.HasPrefix(command, ":clear") {
+		if m.Author.Username != ".warmans" {
+			return false, nil
+		}
+		parts := strings.Split(command, " ")
+		if len(parts) != 2 {
+			return false, nil
+		}
+		return c.guessLimiter.Clear(strings.TrimSpace(parts[1])) > 0, nil
 	}
 
 	if strings.HasPrefix(command, ":explain") {
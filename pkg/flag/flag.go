@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func StringVarEnv(flagsSet *pflag.FlagSet, s *string, prefix string, name string, value string, usage string) {
@@ -25,6 +26,16 @@ func Int64VarEnv(flagsSet *pflag.FlagSet, s *int64, prefix string, name string,
 	int64FromEnv(s, prefix, name)
 }
 
+func Float64VarEnv(flagsSet *pflag.FlagSet, s *float64, prefix string, name string, value float64, usage string) {
+	flagsSet.Float64Var(s, name, value, usage)
+	float64FromEnv(s, prefix, name)
+}
+
+func DurationVarEnv(flagsSet *pflag.FlagSet, s *time.Duration, prefix string, name string, value time.Duration, usage string) {
+	flagsSet.DurationVar(s, name, value, usage)
+	durationFromEnv(s, prefix, name)
+}
+
 func stringFromEnv(p *string, prefix, name string) {
 	if prefix != "" {
 		prefix = strings.ToUpper(prefix) + "_"
@@ -66,6 +77,36 @@ func int64FromEnv(p *int64, prefix, name string) {
 	*p = *valPtr
 }
 
+func float64FromEnv(p *float64, prefix, name string) {
+	if prefix != "" {
+		prefix = "_" + strings.ToUpper(prefix)
+	}
+	val := os.Getenv(fmt.Sprintf("%s%s", prefix, strings.ToUpper(strings.Replace(name, "-", "_", -1))))
+	if val == "" {
+		return
+	}
+	floatVal, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return
+	}
+	*p = floatVal
+}
+
+func durationFromEnv(p *time.Duration, prefix, name string) {
+	if prefix != "" {
+		prefix = "_" + strings.ToUpper(prefix)
+	}
+	val := os.Getenv(fmt.Sprintf("%s%s", prefix, strings.ToUpper(strings.Replace(name, "-", "_", -1))))
+	if val == "" {
+		return
+	}
+	durationVal, err := time.ParseDuration(val)
+	if err != nil {
+		return
+	}
+	*p = durationVal
+}
+
 func Parse() {
 	goflag.Parse()
 }
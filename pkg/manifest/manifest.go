@@ -0,0 +1,166 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var dateInParens = regexp.MustCompile(`[\s-]*\([0-9]+\)`)
+
+// FileNames are the names checked for a manifest in the images directory, in order.
+var FileNames = []string{"manifest.yaml", "manifest.yml", "manifest.json"}
+
+// Item is the metadata for one image in a manifest.
+type Item struct {
+	// File is the name of the image relative to the images directory.
+	File  string `json:"file" yaml:"file"`
+	Entry `yaml:",inline"`
+}
+
+// Manifest lists the images to use in a game along with their answers. It can be either a list of items or
+// an object with an items field.
+type Manifest struct {
+	Items []Item `json:"items" yaml:"items"`
+}
+
+// IsManifest returns true if the file is a manifest rather than an image.
+func IsManifest(fileName string) bool {
+	return slices.Contains(FileNames, strings.ToLower(fileName))
+}
+
+// Find returns the path of the manifest in the images directory or an empty string if there isn't one.
+func Find(imagesDir string) (string, error) {
+	for _, name := range FileNames {
+		manifestPath := path.Join(imagesDir, name)
+		if _, err := os.Stat(manifestPath); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		return manifestPath, nil
+	}
+	return "", nil
+}
+
+// Load reads a JSON or YAML manifest depending on the file extension.
+func Load(manifestPath string) (*Manifest, error) {
+	raw, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	switch strings.ToLower(path.Ext(manifestPath)) {
+	case ".json":
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+			err = json.Unmarshal(raw, &m.Items)
+		} else {
+			err = json.Unmarshal(raw, m)
+		}
+	case ".yaml", ".yml":
+		var node yaml.Node
+		if err = yaml.Unmarshal(raw, &node); err == nil && len(node.Content) > 0 && node.Content[0].Kind == yaml.SequenceNode {
+			err = node.Decode(&m.Items)
+		} else if err == nil {
+			err = node.Decode(m)
+		}
+	default:
+		return nil, fmt.Errorf("unknown manifest format: %s", manifestPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", manifestPath, err)
+	}
+	return m, m.Validate()
+}
+
+// Validate checks every item has a file and answer and no file is listed twice.
+func (m *Manifest) Validate() error {
+	seen := map[string]int{}
+	for k, v := range m.Items {
+		if v.File == "" {
+			return fmt.Errorf("item %d: file is required", k+1)
+		}
+		if strings.TrimSpace(v.Answer) == "" {
+			return fmt.Errorf("item %d (%s): answer is required", k+1, v.File)
+		}
+		if prev, ok := seen[v.File]; ok {
+			return fmt.Errorf("item %d (%s): duplicate of item %d", k+1, v.File, prev)
+		}
+		seen[v.File] = k + 1
+	}
+	return nil
+}
+
+// Get returns the item for the file or nil if it is not in the manifest.
+func (m *Manifest) Get(fileName string) *Item {
+	for k, v := range m.Items {
+		if v.File == fileName {
+			return &m.Items[k]
+		}
+	}
+	return nil
+}
+
+// Items returns the images to use from the manifest at manifestPath. If no path is given the images directory is
+// searched for a manifest. Without a manifest every image in the directory is used with the answer taken from the
+// file name and any metadata from its sidecar file. Files matching ignore are skipped e.g. generated images.
+func Items(imagesDir string, manifestPath string, ignore func(fileName string) bool) ([]Item, error) {
+	if manifestPath == "" {
+		var err error
+		if manifestPath, err = Find(imagesDir); err != nil {
+			return nil, err
+		}
+	}
+	if manifestPath != "" {
+		m, err := Load(manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range m.Items {
+			if _, err := os.Stat(path.Join(imagesDir, v.File)); err != nil {
+				return nil, fmt.Errorf("manifest item %s: %w", v.File, err)
+			}
+		}
+		return m.Items, nil
+	}
+
+	files, err := os.ReadDir(imagesDir)
+	if err != nil {
+		return nil, err
+	}
+	var items []Item
+	for _, fd := range files {
+		if fd.IsDir() || IsSidecar(fd.Name()) || IsManifest(fd.Name()) || (ignore != nil && ignore(fd.Name())) {
+			continue
+		}
+		item := Item{File: fd.Name()}
+		entry, err := LoadSidecar(imagesDir, fd.Name())
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			item.Entry = *entry
+		}
+		if item.Answer == "" {
+			item.Answer = AnswerFromFileName(fd.Name())
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// AnswerFromFileName guesses the answer from an image file name e.g. the-thing-(1982).jpg => the thing.
+func AnswerFromFileName(fileName string) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(fileName, path.Ext(fileName)), ".blur")
+	name = dateInParens.ReplaceAllString(name, " ")
+	name = strings.NewReplacer("-", " ", "_", " ").Replace(name)
+	return strings.Join(strings.Fields(name), " ")
+}
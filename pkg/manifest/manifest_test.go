@@ -0,0 +1,73 @@
+package manifest
+
+import (
+	"os"
+	"path"
+	"testing"
+)
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+	if err := os.WriteFile(path.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestItems_Manifest(t *testing.T) {
+	for name, content := range map[string]string{
+		"manifest.yaml": "- file: The Thing (1982).jpg\n  answer: The Thing\n  aliases: [Thing]\n  clue: Antarctica\n  category: horror\n  difficulty: hard\n",
+		"manifest.json": `{"items": [{"file": "The Thing (1982).jpg", "answer": "The Thing", "aliases": ["Thing"], "clue": "Antarctica", "category": "horror", "difficulty": "hard"}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, dir, "The Thing (1982).jpg", "")
+			writeFile(t, dir, "unlisted.jpg", "")
+			writeFile(t, dir, name, content)
+
+			items, err := Items(dir, "", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != 1 {
+				t.Fatalf("expected only the manifest item got %d", len(items))
+			}
+			item := items[0]
+			if item.Answer != "The Thing" || len(item.Aliases) != 1 || item.Category != "horror" || item.Difficulty != "hard" {
+				t.Fatalf("unexpected item: %+v", item)
+			}
+			if clues := item.AllClues(); len(clues) != 1 || clues[0] != "Antarctica" {
+				t.Fatalf("unexpected clues: %v", clues)
+			}
+		})
+	}
+}
+
+func TestItems_MissingFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "manifest.yaml", "items:\n  - file: missing.jpg\n    answer: Missing\n")
+	if _, err := Items(dir, "", nil); err == nil {
+		t.Fatal("expected missing file to fail")
+	}
+}
+
+func TestItems_FileNames(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "The-Thing (1982).jpg", "")
+	writeFile(t, dir, "fargo.jpg", "")
+	writeFile(t, dir, "fargo.meta.json", `{"answer": "Fargo!", "aliases": ["fargo"]}`)
+
+	items, err := Items(dir, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answers := map[string]string{}
+	for _, v := range items {
+		answers[v.File] = v.Answer
+	}
+	if answers["The-Thing (1982).jpg"] != "The Thing" || answers["fargo.jpg"] != "Fargo!" || len(answers) != 2 {
+		t.Fatalf("unexpected answers: %v", answers)
+	}
+	if _, err := os.Stat(path.Join(dir, "The-Thing (1982).jpg")); err != nil {
+		t.Fatal("expected original file to be left in place")
+	}
+}
@@ -0,0 +1,75 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+const sidecarSuffix = ".meta.json"
+
+// Entry is the optional metadata for a single puzzle image.
+type Entry struct {
+	// Answer replaces the answer derived from the file name.
+	Answer string `json:"answer,omitempty" yaml:"answer,omitempty"`
+	// Aliases are alternative answers that should also be accepted e.g. LOTR.
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	// Rejected are near-misses that should never be accepted even if they are similar to the answer.
+	Rejected []string `json:"rejected,omitempty" yaml:"rejected,omitempty"`
+	// Parts split the answer into separately guessable parts e.g. artist and title.
+	Parts []Part `json:"parts,omitempty" yaml:"parts,omitempty"`
+	// Clue is a custom text clue that can be given by the clue ladder. It is given before any other Clues.
+	Clue string `json:"clue,omitempty" yaml:"clue,omitempty"`
+	// Clues are custom text clues that can be given by the clue ladder.
+	Clues []string `json:"clues,omitempty" yaml:"clues,omitempty"`
+	// Category groups the items e.g. horror.
+	Category string `json:"category,omitempty" yaml:"category,omitempty"`
+	// Difficulty is a free-form rating e.g. easy or 3.
+	Difficulty string `json:"difficulty,omitempty" yaml:"difficulty,omitempty"`
+}
+
+// AllClues returns the single clue followed by the list of clues.
+func (e *Entry) AllClues() []string {
+	if e.Clue == "" {
+		return e.Clues
+	}
+	return append([]string{e.Clue}, e.Clues...)
+}
+
+// Part is one part of a compound answer.
+type Part struct {
+	// Label describes the part e.g. year.
+	Label   string   `json:"label,omitempty" yaml:"label,omitempty"`
+	Answer  string   `json:"answer" yaml:"answer"`
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+}
+
+// IsSidecar returns true if the file is a metadata file rather than an image.
+func IsSidecar(fileName string) bool {
+	return strings.HasSuffix(fileName, sidecarSuffix)
+}
+
+// SidecarName e.g. fargo.jpg => fargo.meta.json
+func SidecarName(imageName string) string {
+	return strings.TrimSuffix(imageName, path.Ext(imageName)) + sidecarSuffix
+}
+
+// LoadSidecar loads the metadata for the given image. If no metadata exists a nil entry is returned.
+func LoadSidecar(imagesDir string, imageName string) (*Entry, error) {
+	f, err := os.Open(path.Join(imagesDir, SidecarName(imageName)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	entry := &Entry{}
+	if err := json.NewDecoder(f).Decode(entry); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", SidecarName(imageName), err)
+	}
+	return entry, nil
+}
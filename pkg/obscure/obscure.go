@@ -0,0 +1,469 @@
+package obscure
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"math/rand/v2"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+var stageSuffix = regexp.MustCompile(`\.stage[0-9]+\.[^.]+$`)
+
+// Image is an original image and the name of its obscured copy in the same directory.
+type Image struct {
+	Original string
+	Obscured string
+}
+
+type Mode string
+
+const (
+	ModeBlur       Mode = "blur"
+	ModePixelate   Mode = "pixelate"
+	ModeShuffle    Mode = "shuffle"
+	ModeCrop       Mode = "crop"
+	ModeSilhouette Mode = "silhouette"
+	ModeMask       Mode = "mask"
+)
+
+// Modes are all the supported modes.
+var Modes = []Mode{ModeBlur, ModePixelate, ModeShuffle, ModeCrop, ModeSilhouette, ModeMask}
+
+// ParseModes parses a comma separated list of modes e.g. "mask,blur". The modes are applied in order.
+func ParseModes(raw string) ([]Mode, error) {
+	var modes []Mode
+	for _, v := range strings.Split(raw, ",") {
+		mode := Mode(strings.ToLower(strings.TrimSpace(v)))
+		if mode == "" {
+			continue
+		}
+		if !slices.Contains(Modes, mode) {
+			return nil, fmt.Errorf("unknown obscure mode: %s", mode)
+		}
+		modes = append(modes, mode)
+	}
+	if len(modes) == 0 {
+		return nil, fmt.Errorf("no obscure mode given")
+	}
+	return modes, nil
+}
+
+// ModeNames returns the supported modes as a comma separated list for help text.
+func ModeNames() string {
+	names := make([]string, len(Modes))
+	for k, v := range Modes {
+		names[k] = string(v)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Apply obscures the image. Strength is between 0 (unchanged) and 1 (barely recognisable).
+func Apply(img image.Image, mode Mode, strength float64) (image.Image, error) {
+	strength = min(1, max(0, strength))
+	shortest := min(img.Bounds().Dx(), img.Bounds().Dy())
+	switch mode {
+	case ModeBlur:
+		return Blur(img, int(strength*float64(shortest)/10)), nil
+	case ModePixelate:
+		return Pixelate(img, int(strength*float64(shortest)/6)), nil
+	case ModeShuffle:
+		return Shuffle(img, 1+int(math.Round(strength*7))), nil
+	case ModeCrop:
+		return Crop(img, 1-strength*0.85), nil
+	case ModeSilhouette:
+		return Silhouette(img, strength), nil
+	case ModeMask:
+		return MaskText(img, strength), nil
+	}
+	return nil, fmt.Errorf("unknown obscure mode: %s", mode)
+}
+
+// ApplyAll applies each of the modes in order with the same strength.
+func ApplyAll(img image.Image, modes []Mode, strength float64) (image.Image, error) {
+	var err error
+	for _, mode := range modes {
+		if img, err = Apply(img, mode, strength); err != nil {
+			return nil, err
+		}
+	}
+	return img, nil
+}
+
+// Blur approximates a gaussian blur with three box blurs of the given radius.
+func Blur(img image.Image, radius int) *image.RGBA {
+	out := toRGBA(img)
+	if radius < 1 {
+		return out
+	}
+	tmp := image.NewRGBA(out.Bounds())
+	for range 3 {
+		boxBlur(out, tmp, radius, true)
+		boxBlur(tmp, out, radius, false)
+	}
+	return out
+}
+
+// boxBlur averages each pixel with its neighbours in one direction using a sliding window.
+func boxBlur(src *image.RGBA, dst *image.RGBA, radius int, horizontal bool) {
+	b := src.Bounds()
+	lines, length := b.Dy(), b.Dx()
+	if !horizontal {
+		lines, length = b.Dx(), b.Dy()
+	}
+	offset := func(line, i int) int {
+		if horizontal {
+			return src.PixOffset(b.Min.X+i, b.Min.Y+line)
+		}
+		return src.PixOffset(b.Min.X+line, b.Min.Y+i)
+	}
+	window := 2*radius + 1
+	for line := range lines {
+		var sum [4]int
+		// pixels beyond the edge repeat the edge pixel
+		for i := -radius; i <= radius; i++ {
+			o := offset(line, min(length-1, max(0, i)))
+			for c := range 4 {
+				sum[c] += int(src.Pix[o+c])
+			}
+		}
+		for i := range length {
+			o := offset(line, i)
+			for c := range 4 {
+				dst.Pix[o+c] = uint8(sum[c] / window)
+			}
+			add := offset(line, min(length-1, i+radius+1))
+			remove := offset(line, max(0, i-radius))
+			for c := range 4 {
+				sum[c] += int(src.Pix[add+c]) - int(src.Pix[remove+c])
+			}
+		}
+	}
+}
+
+// Pixelate replaces blocks of the given size with a single colour.
+func Pixelate(img image.Image, blockSize int) *image.RGBA {
+	if blockSize < 2 {
+		return toRGBA(img)
+	}
+	b := img.Bounds()
+	small := image.NewRGBA(image.Rect(0, 0, max(1, b.Dx()/blockSize), max(1, b.Dy()/blockSize)))
+	draw.ApproxBiLinear.Scale(small, small.Bounds(), img, b, draw.Src, nil)
+
+	out := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.NearestNeighbor.Scale(out, out.Bounds(), small, small.Bounds(), draw.Src, nil)
+	return out
+}
+
+// Shuffle splits the image into a grid of tiles and moves every tile to a different position. The
+// order only depends on the image size so regenerating the image gives the same result.
+func Shuffle(img image.Image, tilesPerSide int) *image.RGBA {
+	src := toRGBA(img)
+	if tilesPerSide < 2 {
+		return src
+	}
+	b := src.Bounds()
+	tile := func(idx int) image.Rectangle {
+		col, row := idx%tilesPerSide, idx/tilesPerSide
+		return image.Rect(
+			col*b.Dx()/tilesPerSide,
+			row*b.Dy()/tilesPerSide,
+			(col+1)*b.Dx()/tilesPerSide,
+			(row+1)*b.Dy()/tilesPerSide,
+		)
+	}
+
+	// Sattolo's algorithm only produces a single cycle, so no tile stays where it was.
+	order := make([]int, tilesPerSide*tilesPerSide)
+	for k := range order {
+		order[k] = k
+	}
+	rnd := rand.New(rand.NewPCG(uint64(b.Dx()), uint64(b.Dy())))
+	for i := len(order) - 1; i > 0; i-- {
+		j := rnd.IntN(i)
+		order[i], order[j] = order[j], order[i]
+	}
+
+	out := image.NewRGBA(b)
+	for k, v := range order {
+		draw.ApproxBiLinear.Scale(out, tile(k), src, tile(v), draw.Src, nil)
+	}
+	return out
+}
+
+// Crop zooms in on a region of the image covering the given fraction of each side. The region is scaled
+// back up to the original size. The centre of the region only depends on the image size so progressively
+// larger crops zoom out from the same place.
+func Crop(img image.Image, fraction float64) *image.RGBA {
+	src := toRGBA(img)
+	fraction = min(1, max(0.05, fraction))
+	b := src.Bounds()
+	width, height := int(float64(b.Dx())*fraction), int(float64(b.Dy())*fraction)
+	if width >= b.Dx() && height >= b.Dy() {
+		return src
+	}
+	rnd := rand.New(rand.NewPCG(uint64(b.Dy()), uint64(b.Dx())))
+	centreX := int(float64(b.Dx()) * (0.25 + rnd.Float64()*0.5))
+	centreY := int(float64(b.Dy()) * (0.25 + rnd.Float64()*0.5))
+
+	minX := min(b.Dx()-width, max(0, centreX-width/2))
+	minY := min(b.Dy()-height, max(0, centreY-height/2))
+
+	out := image.NewRGBA(b)
+	draw.ApproxBiLinear.Scale(out, b, src, image.Rect(minX, minY, minX+max(1, width), minY+max(1, height)), draw.Src, nil)
+	return out
+}
+
+// Silhouette replaces everything that differs from the background colour with black and the background
+// with white. The background colour is the average of the pixels around the edge of the image. Lower
+// strengths blend the silhouette with the original image.
+func Silhouette(img image.Image, strength float64) *image.RGBA {
+	out := toRGBA(img)
+	if strength <= 0 {
+		return out
+	}
+	b := out.Bounds()
+
+	var bg [3]float64
+	var edgePixels float64
+	for y := range b.Dy() {
+		for x := range b.Dx() {
+			if x != 0 && y != 0 && x != b.Dx()-1 && y != b.Dy()-1 {
+				continue
+			}
+			o := out.PixOffset(x, y)
+			for c := range 3 {
+				bg[c] += float64(out.Pix[o+c])
+			}
+			edgePixels++
+		}
+	}
+	for c := range 3 {
+		bg[c] /= edgePixels
+	}
+
+	for y := range b.Dy() {
+		for x := range b.Dx() {
+			o := out.PixOffset(x, y)
+			var dist float64
+			for c := range 3 {
+				dist += math.Pow(float64(out.Pix[o+c])-bg[c], 2)
+			}
+			target := 255.0
+			// more than ~20% of the maximum distance from the background
+			if math.Sqrt(dist) > 88 {
+				target = 0
+			}
+			for c := range 3 {
+				out.Pix[o+c] = uint8(float64(out.Pix[o+c])*(1-strength) + target*strength)
+			}
+			out.Pix[o+3] = 255
+		}
+	}
+	return out
+}
+
+// MaskText covers horizontal bands of the image that contain a lot of fine detail with black bars. This is a
+// heuristic for the title text on a poster: text has many more sharp edges per row than the rest of the image.
+// Higher strengths mask more of the image.
+func MaskText(img image.Image, strength float64) *image.RGBA {
+	out := toRGBA(img)
+	if strength <= 0 {
+		return out
+	}
+	b := out.Bounds()
+
+	luminance := func(x, y int) float64 {
+		return float64(color.GrayModel.Convert(out.RGBAAt(x, y)).(color.Gray).Y)
+	}
+	scores := make([]float64, b.Dy())
+	var mean float64
+	for y := range b.Dy() {
+		for x := 1; x < b.Dx(); x++ {
+			scores[y] += math.Abs(luminance(x, y) - luminance(x-1, y))
+		}
+		scores[y] /= float64(b.Dx())
+		mean += scores[y]
+	}
+	mean /= float64(len(scores))
+	var variance float64
+	for _, v := range scores {
+		variance += math.Pow(v-mean, 2)
+	}
+	stdDev := math.Sqrt(variance / float64(len(scores)))
+	if stdDev == 0 {
+		return out
+	}
+	threshold := mean + stdDev*(1.5-strength)
+
+	// widen each band a little so the edges of the letters are also covered
+	padding := max(1, b.Dy()/50)
+	masked := make([]bool, b.Dy())
+	for y, v := range scores {
+		if v <= threshold {
+			continue
+		}
+		for p := max(0, y-padding); p < min(b.Dy(), y+padding+1); p++ {
+			masked[p] = true
+		}
+	}
+
+	// very tall bands are more likely to be detailed artwork than text
+	for start := 0; start < len(masked); {
+		if !masked[start] {
+			start++
+			continue
+		}
+		end := start
+		for end < len(masked) && masked[end] {
+			end++
+		}
+		if end-start <= b.Dy()/3 {
+			draw.Draw(out, image.Rect(0, start, b.Dx(), end), image.NewUniform(color.Black), image.Point{}, draw.Src)
+		}
+		start = end
+	}
+	return out
+}
+
+// Stages creates n progressively less obscured versions of the image, from the strongest to the weakest.
+func Stages(img image.Image, mode Mode, n int) ([]image.Image, error) {
+	stages := make([]image.Image, n)
+	for i := range n {
+		stage, err := Apply(img, mode, float64(n-i)/float64(n))
+		if err != nil {
+			return nil, err
+		}
+		stages[i] = stage
+	}
+	return stages, nil
+}
+
+// StageName e.g. fargo.jpg => fargo.stage1.jpg
+func StageName(imageName string, stage int) string {
+	ext := path.Ext(imageName)
+	return fmt.Sprintf("%s.stage%d%s", strings.TrimSuffix(imageName, ext), stage, ext)
+}
+
+// IsStage returns true if the file is a generated stage rather than an original image.
+func IsStage(fileName string) bool {
+	return stageSuffix.MatchString(fileName)
+}
+
+// ObscuredName e.g. fargo.jpg => fargo.blur.jpg
+func ObscuredName(imageName string) string {
+	ext := path.Ext(imageName)
+	return fmt.Sprintf("%s.blur%s", strings.TrimSuffix(imageName, ext), ext)
+}
+
+// IsObscured returns true if the file is an obscured copy rather than an original image.
+func IsObscured(fileName string) bool {
+	return strings.Contains(fileName, ".blur.")
+}
+
+// GenerateStages writes the reveal stages of the image to the same directory and returns their names.
+func GenerateStages(imagesDir string, imageName string, mode Mode, n int) ([]string, error) {
+	img, err := Load(path.Join(imagesDir, imageName))
+	if err != nil {
+		return nil, err
+	}
+	stages, err := Stages(img, mode, n)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(stages))
+	for k, v := range stages {
+		names[k] = StageName(imageName, k+1)
+		if err := Save(path.Join(imagesDir, names[k]), v); err != nil {
+			return nil, fmt.Errorf("failed to save %s: %w", names[k], err)
+		}
+	}
+	return names, nil
+}
+
+// Generate writes an obscured copy of the image to the same directory.
+func Generate(imagesDir string, imageName string, obscuredName string, modes []Mode, strength float64) error {
+	img, err := Load(path.Join(imagesDir, imageName))
+	if err != nil {
+		return err
+	}
+	obscured, err := ApplyAll(img, modes, strength)
+	if err != nil {
+		return err
+	}
+	if err := Save(path.Join(imagesDir, obscuredName), obscured); err != nil {
+		return fmt.Errorf("failed to save %s: %w", obscuredName, err)
+	}
+	return nil
+}
+
+// GenerateAll writes an obscured copy of each image using the comma separated modes, then verifies every image
+// has a usable obscured copy. If no modes are given the existing copies are only verified.
+func GenerateAll(imagesDir string, images []Image, rawModes string, strength float64) error {
+	if rawModes != "" {
+		modes, err := ParseModes(rawModes)
+		if err != nil {
+			return err
+		}
+		for _, v := range images {
+			if err := Generate(imagesDir, v.Original, v.Obscured, modes, strength); err != nil {
+				return err
+			}
+		}
+	}
+	var errs []error
+	for _, v := range images {
+		if err := VerifyFile(imagesDir, v.Original, v.Obscured); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("obscured images failed verification: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func Load(imagePath string) (image.Image, error) {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", imagePath, err)
+	}
+	return img, nil
+}
+
+// Save encodes the image based on the file extension.
+func Save(imagePath string, img image.Image) error {
+	f, err := os.Create(imagePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	switch strings.ToLower(path.Ext(imagePath)) {
+	case ".jpg", ".jpeg":
+		return jpeg.Encode(f, img, &jpeg.Options{Quality: 90})
+	default:
+		return png.Encode(f, img)
+	}
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	out := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(out, out.Bounds(), img, b.Min, draw.Src)
+	return out
+}
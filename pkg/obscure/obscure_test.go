@@ -0,0 +1,129 @@
+package obscure
+
+import (
+	"image"
+	"image/color"
+	"path"
+	"testing"
+)
+
+func testImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 60, 90))
+	for y := range 90 {
+		for x := range 60 {
+			if (x/5+y/5)%2 == 0 {
+				img.Set(x, y, color.White)
+			} else {
+				img.Set(x, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+func TestStages(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(string(mode), func(t *testing.T) {
+			stages, err := Stages(testImage(), mode, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stages) != 3 {
+				t.Fatalf("expected 3 stages got %d", len(stages))
+			}
+			for _, v := range stages {
+				if v.Bounds().Dx() != 60 || v.Bounds().Dy() != 90 {
+					t.Fatalf("expected stage to keep the image size, got %v", v.Bounds())
+				}
+			}
+			if stages[0] == stages[2] {
+				t.Fatal("expected stages to differ")
+			}
+		})
+	}
+}
+
+func TestIsStage(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"fargo.stage1.jpg":    true,
+		StageName("a.png", 2): true,
+		"fargo.jpg":           false,
+		"backstage.jpg":       false,
+	} {
+		if IsStage(name) != expected {
+			t.Errorf("%s: expected %v", name, expected)
+		}
+	}
+}
+
+func TestGenerateAll(t *testing.T) {
+	dir := t.TempDir()
+	if err := Save(path.Join(dir, "a.png"), testImage()); err != nil {
+		t.Fatal(err)
+	}
+	images := []Image{{Original: "a.png", Obscured: ObscuredName("a.png")}}
+	if images[0].Obscured != "a.blur.png" || !IsObscured(images[0].Obscured) {
+		t.Fatalf("unexpected obscured name %s", images[0].Obscured)
+	}
+	if err := GenerateAll(dir, images, "pixelate", 0.6); err != nil {
+		t.Fatal(err)
+	}
+	// existing images are only verified
+	if err := GenerateAll(dir, images, "", 0.6); err != nil {
+		t.Fatal(err)
+	}
+	if err := Save(path.Join(dir, images[0].Obscured), testImage()); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateAll(dir, images, "", 0.6); err == nil {
+		t.Fatal("expected a copy of the original to fail verification")
+	}
+}
+
+// posterImage has a plain background, a dark shape in the middle and a band of fine stripes like a title.
+func posterImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 60, 90))
+	for y := range 90 {
+		for x := range 60 {
+			switch {
+			case y >= 75 && y < 80 && x%2 == 0:
+				img.Set(x, y, color.Black)
+			case x >= 20 && x < 40 && y >= 25 && y < 60:
+				img.Set(x, y, color.RGBA{R: 40, G: 20, B: 20, A: 255})
+			default:
+				img.Set(x, y, color.RGBA{R: 200, G: 220, B: uint8(150 + y), A: 255})
+			}
+		}
+	}
+	return img
+}
+
+func TestApply(t *testing.T) {
+	for _, mode := range Modes {
+		t.Run(string(mode), func(t *testing.T) {
+			obscured, err := Apply(posterImage(), mode, 0.6)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := Verify(posterImage(), obscured); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	if err := Verify(posterImage(), posterImage()); err == nil {
+		t.Fatal("expected an identical image to fail verification")
+	}
+}
+
+func TestParseModes(t *testing.T) {
+	modes, err := ParseModes("mask, blur")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(modes) != 2 || modes[0] != ModeMask || modes[1] != ModeBlur {
+		t.Fatalf("unexpected modes: %v", modes)
+	}
+	if _, err := ParseModes("sepia"); err == nil {
+		t.Fatal("expected unknown mode to fail")
+	}
+}
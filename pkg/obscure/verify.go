@@ -0,0 +1,60 @@
+package obscure
+
+import (
+	"fmt"
+	"image"
+	"path"
+)
+
+// MinDifference is the minimum mean difference per channel (0-255) between an image and its obscured
+// version. Anything lower is likely to be a copy of the original.
+const MinDifference = 2.0
+
+// Verify checks the obscured image is usable in place of the original i.e. it is the same size and
+// doesn't give the answer away by being (almost) identical.
+func Verify(original image.Image, obscured image.Image) error {
+	if original.Bounds().Dx() != obscured.Bounds().Dx() || original.Bounds().Dy() != obscured.Bounds().Dy() {
+		return fmt.Errorf(
+			"size %dx%d does not match the original %dx%d",
+			obscured.Bounds().Dx(),
+			obscured.Bounds().Dy(),
+			original.Bounds().Dx(),
+			original.Bounds().Dy(),
+		)
+	}
+	if diff := Difference(original, obscured); diff < MinDifference {
+		return fmt.Errorf("too similar to the original (difference %.2f, minimum %.2f)", diff, MinDifference)
+	}
+	return nil
+}
+
+// VerifyFile loads the original and obscured images from the directory and verifies them.
+func VerifyFile(imagesDir string, imageName string, obscuredName string) error {
+	original, err := Load(path.Join(imagesDir, imageName))
+	if err != nil {
+		return err
+	}
+	obscured, err := Load(path.Join(imagesDir, obscuredName))
+	if err != nil {
+		return err
+	}
+	if err := Verify(original, obscured); err != nil {
+		return fmt.Errorf("%s: %w", obscuredName, err)
+	}
+	return nil
+}
+
+// Difference returns the mean absolute difference per colour channel of two images of the same size.
+func Difference(a image.Image, b image.Image) float64 {
+	ra, rb := toRGBA(a), toRGBA(b)
+	var total float64
+	for y := range ra.Bounds().Dy() {
+		for x := range ra.Bounds().Dx() {
+			oa, ob := ra.PixOffset(x, y), rb.PixOffset(x, y)
+			for c := range 3 {
+				total += float64(max(ra.Pix[oa+c], rb.Pix[ob+c]) - min(ra.Pix[oa+c], rb.Pix[ob+c]))
+			}
+		}
+	}
+	return total / float64(ra.Bounds().Dx()*ra.Bounds().Dy()*3)
+}
@@ -0,0 +1,186 @@
+package picturequiz
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/warmans/gamesmaster/pkg/clues"
+	"github.com/warmans/gamesmaster/pkg/manifest"
+	"github.com/warmans/gamesmaster/pkg/obscure"
+	"github.com/warmans/gamesmaster/pkg/util"
+)
+
+// Item is one picture players need to identify.
+type Item struct {
+	// OriginalImage is shown once the item is guessed.
+	OriginalImage string
+	// ObscuredImage is shown until the item is guessed (optional, the original is shown if empty).
+	ObscuredImage string
+	Answer        string
+	Aliases       []string
+	Rejected      []string
+	Parts         []*AnswerPart
+	Guessed       bool
+	// GuessedBy is the user that completed the answer.
+	GuessedBy string
+	// Revealed is true if the answer was given away when the game ended rather than guessed.
+	Revealed bool
+	// Stages are progressively less obscured images revealed over the game.
+	Stages       []string
+	Stage        int
+	WrongGuesses int
+	// Clues are custom text clues e.g. from the image metadata.
+	Clues     []string
+	ClueUsage clues.Usage
+	// Category and Difficulty are optional metadata from the manifest.
+	Category   string
+	Difficulty string
+}
+
+// NewItem creates an item from an image's manifest entry. The obscured copy of the image is only used if obscured is
+// true.
+func NewItem(m manifest.Item, obscured bool) *Item {
+	item := &Item{
+		OriginalImage: m.File,
+		Answer:        m.Answer,
+		Aliases:       m.Aliases,
+		Rejected:      m.Rejected,
+		Clues:         m.AllClues(),
+		Category:      m.Category,
+		Difficulty:    m.Difficulty,
+	}
+	if obscured {
+		item.ObscuredImage = obscure.ObscuredName(m.File)
+	}
+	for _, part := range m.Parts {
+		item.Parts = append(item.Parts, &AnswerPart{Label: part.Label, Answer: part.Answer, Aliases: part.Aliases})
+	}
+	return item
+}
+
+// UnmarshalJSON also reads items saved by the image game before the games shared an engine, which stored the
+// image as Path.
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type item Item
+	legacy := struct {
+		*item
+		Path string
+	}{item: (*item)(i)}
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	if i.OriginalImage == "" {
+		i.OriginalImage = legacy.Path
+	}
+	return nil
+}
+
+// IsCorrect checks the guess against the answer and any aliases.
+func (i *Item) IsCorrect(matcher *util.Matcher, guess string) bool {
+	return matcher.MatchesAny(guess, append([]string{i.Answer}, i.Aliases...), i.Rejected)
+}
+
+// Classify checks how close the guess was to the answer, any aliases or any of the answer parts.
+func (i *Item) Classify(matcher *util.Matcher, guess string) util.GuessResult {
+	accepted := append([]string{i.Answer}, i.Aliases...)
+	for _, v := range i.Parts {
+		accepted = append(accepted, v.accepted()...)
+	}
+	return matcher.Classify(guess, accepted, i.Rejected)
+}
+
+// MatchPart returns the answer part matched by the guess or nil if no part matched.
+func (i *Item) MatchPart(matcher *util.Matcher, guess string) *AnswerPart {
+	for _, v := range i.Parts {
+		if matcher.MatchesAny(guess, v.accepted(), i.Rejected) {
+			return v
+		}
+	}
+	return nil
+}
+
+// AllPartsGuessed returns true if the answer has parts and all of them have been guessed.
+func (i *Item) AllPartsGuessed() bool {
+	if len(i.Parts) == 0 {
+		return false
+	}
+	for _, v := range i.Parts {
+		if !v.Guessed {
+			return false
+		}
+	}
+	return true
+}
+
+// SetGuessed marks the answer and all of its parts as guessed by the user.
+func (i *Item) SetGuessed(user string) {
+	i.Guessed = true
+	i.GuessedBy = user
+	for _, v := range i.Parts {
+		v.Guessed = true
+	}
+}
+
+// Reveal marks an unguessed answer as revealed so it is shown on the board without a solver.
+func (i *Item) Reveal() {
+	if i.Guessed {
+		return
+	}
+	i.Guessed = true
+	i.Revealed = true
+	for _, v := range i.Parts {
+		v.Guessed = true
+	}
+}
+
+// CurrentImage returns the image to show for the item's current state.
+func (i *Item) CurrentImage() string {
+	if i.Guessed {
+		return i.OriginalImage
+	}
+	if len(i.Stages) > 0 {
+		return i.Stages[min(max(0, i.Stage), len(i.Stages)-1)]
+	}
+	if i.ObscuredImage != "" {
+		return i.ObscuredImage
+	}
+	return i.OriginalImage
+}
+
+// RevealStage returns the stage that should be shown. Each stage is unlocked after an equal share of the game
+// duration or after the configured number of wrong guesses.
+func (i *Item) RevealStage(elapsed time.Duration, duration time.Duration, wrongGuessesPerStage int) int {
+	if len(i.Stages) == 0 {
+		return 0
+	}
+	stage := i.Stage
+	if duration > 0 {
+		stage = max(stage, int(float64(elapsed)/float64(duration)*float64(len(i.Stages))))
+	}
+	if wrongGuessesPerStage > 0 {
+		stage = max(stage, i.WrongGuesses/wrongGuessesPerStage)
+	}
+	return min(stage, len(i.Stages)-1)
+}
+
+// AnswerPart is one part of a compound answer e.g. the artist in "artist - title". Parts can be
+// guessed separately and the item is complete once all parts have been guessed.
+type AnswerPart struct {
+	Label   string
+	Answer  string
+	Aliases []string
+	Guessed bool
+}
+
+func (a *AnswerPart) accepted() []string {
+	return append([]string{a.Answer}, a.Aliases...)
+}
+
+// String e.g. year: 1996
+func (a *AnswerPart) String() string {
+	if a.Label == "" {
+		return a.Answer
+	}
+	return fmt.Sprintf("%s: %s", a.Label, a.Answer)
+}
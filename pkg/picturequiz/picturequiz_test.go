@@ -0,0 +1,155 @@
+package picturequiz
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/gamesmaster/pkg/util"
+)
+
+func TestState_UnmarshalLegacy(t *testing.T) {
+	crossfilm := `{"GameTitle":"old","FilmgameState":[{"OriginalImage":"a.jpg","Answer":"fargo"}],"CrosswordState":{"Words":[]}}`
+	s := &State{}
+	if err := json.Unmarshal([]byte(crossfilm), s); err != nil {
+		t.Fatal(err)
+	}
+	if s.GameTitle != "old" || len(s.Posters) != 1 || s.Posters[0].Answer != "fargo" || s.Crossword == nil {
+		t.Fatalf("crossfilm state was not migrated: %+v", s)
+	}
+
+	imagegame := `{"Posters":[{"Path":"b.png","Answer":"the thing"}]}`
+	s = &State{}
+	if err := json.Unmarshal([]byte(imagegame), s); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Posters) != 1 || s.Posters[0].OriginalImage != "b.png" || s.Posters[0].CurrentImage() != "b.png" {
+		t.Fatalf("imagegame state was not migrated: %+v", s.Posters)
+	}
+}
+
+func TestState_Guess(t *testing.T) {
+	s := &State{
+		Posters: []*Item{
+			{Answer: "fargo"},
+			{Answer: "artist - title", Parts: []*AnswerPart{{Label: "artist", Answer: "artist"}, {Label: "title", Answer: "title"}}},
+		},
+		Scores:    scores.NewTiered(2),
+		StartedAt: time.Now(),
+	}
+	if out := s.Guess("1", "a", "the thing"); out.Correct || out.Result == util.GuessCorrect {
+		t.Fatalf("wrong guess was accepted: %+v", out)
+	}
+	if s.Posters[0].WrongGuesses != 1 {
+		t.Fatalf("expected 1 wrong guess got %d", s.Posters[0].WrongGuesses)
+	}
+	if out := s.Guess("1", "a", "fargo"); !out.Correct || out.Complete {
+		t.Fatalf("unexpected outcome: %+v", out)
+	}
+	if out := s.Guess("1", "b", "fargo"); !out.AlreadySolved {
+		t.Fatalf("expected already solved: %+v", out)
+	}
+	if out := s.Guess("2", "b", "artist"); !out.PartCorrect || out.Correct {
+		t.Fatalf("expected part correct: %+v", out)
+	}
+	if out := s.Guess("2", "a", "title"); !out.Correct || !out.Complete {
+		t.Fatalf("expected game complete: %+v", out)
+	}
+	if s.Posters[1].GuessedBy != "a" {
+		t.Fatalf("expected guessed by a got %s", s.Posters[1].GuessedBy)
+	}
+	if out := s.Guess("3", "a", "fargo"); out.Correct {
+		t.Fatalf("guess at missing item was accepted: %+v", out)
+	}
+}
+
+func TestVariant_AllowGuess(t *testing.T) {
+	s := &State{
+		Posters: []*Item{{Answer: "a"}, {Answer: "b"}, {Answer: "c"}, {Answer: "d"}, {Answer: "e"}},
+		Scores:  scores.NewTiered(5),
+		Host:    "host",
+	}
+	s.Scores.Add("player")
+
+	if Filmgame.AllowGuess(s, "player") {
+		t.Fatal("film game should require alternating users")
+	}
+	if !Filmgame.AllowGuess(s, "other") {
+		t.Fatal("other users should be allowed to guess")
+	}
+	if Filmgame.AllowGuess(s, "host") {
+		t.Fatal("the host should not be allowed to guess")
+	}
+
+	s.Cfg = &Config{}
+	if !ImageGame.AllowGuess(s, "player") {
+		t.Fatal("image game should only require alternating users if configured")
+	}
+	s.Cfg.RequireAlternatingUsers = true
+	if ImageGame.AllowGuess(s, "player") {
+		t.Fatal("image game should require alternating users when configured")
+	}
+	s.Posters[0].Guessed, s.Posters[1].Guessed = true, true
+	if !ImageGame.AllowGuess(s, "player") {
+		t.Fatal("image game should allow repeat guesses once 3 are left")
+	}
+}
+
+func TestVariant_Prepare(t *testing.T) {
+	s := &State{}
+	ImageGame.Prepare(s)
+	if s.Cfg == nil || s.Cfg.ImagesWidth != 200 || s.Duration != time.Hour*24*7 || len(s.Cfg.ClueLadder) == 0 {
+		t.Fatalf("defaults were not applied: %+v %+v", s, s.Cfg)
+	}
+	if ImageGame.StateFile("../123") != "var/imagegame/game/123.json" || Filmgame.StateFile("123") != "var/filmgame/game/current.json" {
+		t.Fatal("unexpected state file")
+	}
+}
+
+func TestNewStateFromManifest(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.jpg", "a.blur.jpg", "b.jpg"} {
+		if err := os.WriteFile(path.Join(dir, name), nil, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	manifest := `
+- file: a.jpg
+  answer: Fargo
+  clues: [snow]
+  parts:
+    - {label: title, answer: Fargo}
+    - {label: year, answer: "1996"}
+- file: b.jpg
+  answer: Heat
+`
+	if err := os.WriteFile(path.Join(dir, "manifest.yaml"), []byte(manifest), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []*Variant{Filmgame, ImageGame, Crossfilm} {
+		s, err := NewStateFromManifest(v, dir, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(s.Posters) != 2 || s.Scores == nil {
+			t.Fatalf("%s: unexpected state %+v", v.Name, s)
+		}
+		fargo := s.Posters[0]
+		if fargo.Answer != "Fargo" {
+			fargo = s.Posters[1]
+		}
+		if len(fargo.Parts) != 2 || len(fargo.Clues) != 1 {
+			t.Errorf("%s: expected manifest parts and clues, got %+v", v.Name, fargo)
+		}
+		if (fargo.ObscuredImage == "a.blur.jpg") != v.Obscured {
+			t.Errorf("%s: unexpected obscured image %s", v.Name, fargo.ObscuredImage)
+		}
+		if len(s.ObscuredImages()) > 0 != v.Obscured {
+			t.Errorf("%s: unexpected obscured images %v", v.Name, s.ObscuredImages())
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package picturequiz
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"github.com/warmans/go-crossword/v2"
+	"golang.org/x/image/font/gofont/goregular"
+
+	"github.com/warmans/gamesmaster/pkg/render"
+)
+
+var font *truetype.Font
+
+// revealedColor marks answers that were given away at the end of the game.
+var revealedColor = color.RGBA{R: 255, G: 120, B: 0, A: 255}
+
+func init() {
+	var err error
+	font, err = truetype.Parse(goregular.TTF)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Renderer draws the board of a game split into pages.
+type Renderer interface {
+	Render(imagesDir string, state *State) ([]*render.Page, error)
+}
+
+// Grid draws the items in rows with their number in the corner. Items are numbered continuously across pages.
+type Grid struct {
+	// Columns is the preferred number of items per row.
+	Columns int
+	// TileWidth and TileHeight are used if the game config has no image size.
+	TileWidth  int
+	TileHeight int
+	// MaxWidth and MaxHeight are used if the game config has no maximum board size (0 for the render defaults).
+	MaxWidth  int
+	MaxHeight int
+	// LabelSize is the font size of the item numbers. Captions are slightly smaller.
+	LabelSize float64
+
+	// boards caches the rendered pages of each game.
+	boards render.Boards
+}
+
+// Layout splits the board into pages that fit in the configured maximum size.
+func (g *Grid) Layout(cfg *Config) render.Layout {
+	layout := render.Layout{
+		TileWidth:  g.TileWidth,
+		TileHeight: g.TileHeight,
+		Columns:    g.Columns,
+		MaxWidth:   render.DefaultMaxWidth,
+		MaxHeight:  render.DefaultMaxHeight,
+	}
+	if g.MaxWidth > 0 {
+		layout.MaxWidth = g.MaxWidth
+	}
+	if g.MaxHeight > 0 {
+		layout.MaxHeight = g.MaxHeight
+	}
+	if cfg == nil {
+		return layout
+	}
+	if cfg.ImagesWidth > 0 && cfg.ImagesHeight > 0 {
+		layout.TileWidth, layout.TileHeight = int(cfg.ImagesWidth), int(cfg.ImagesHeight)
+	}
+	if cfg.MaxBoardWidth > 0 {
+		layout.MaxWidth = cfg.MaxBoardWidth
+	}
+	if cfg.MaxBoardHeight > 0 {
+		layout.MaxHeight = cfg.MaxBoardHeight
+	}
+	return layout
+}
+
+func (g *Grid) Render(imagesDir string, state *State) ([]*render.Page, error) {
+	layout := g.Layout(state.Cfg)
+	pages := make([]*render.Page, layout.NumPages(len(state.Posters)))
+	for page := range pages {
+		first, last := layout.PageItems(page, len(state.Posters))
+		tiles := make([]render.Tile, last-first)
+		keys := make([]string, last-first)
+		for k, v := range state.Posters[first:last] {
+			imagePath := path.Join(imagesDir, v.CurrentImage())
+			x, y := layout.Position(first + k)
+			tiles[k] = render.Tile{
+				Key:       tileKey(imagePath, v),
+				ImagePath: imagePath,
+				X:         x,
+				Y:         y,
+				Width:     layout.TileWidth,
+				Height:    layout.TileHeight,
+			}
+			keys[k] = tiles[k].Key
+		}
+		board := g.boards.Get(fmt.Sprintf("%s#%d", path.Join(imagesDir, state.GuildID), page))
+		canvas, err := board.Render(render.DefaultCache, layout.PageWidth(), layout.PageHeight(len(tiles)), tiles, func(dc *gg.Context, k int, img image.Image) {
+			g.drawTile(dc, first+k, state.Posters[first+k], img, float64(tiles[k].X), float64(tiles[k].Y), float64(layout.TileWidth), float64(layout.TileHeight))
+		})
+		if err != nil {
+			return nil, err
+		}
+		pages[page] = &render.Page{Number: page + 1, First: first + 1, Last: last, Key: strings.Join(keys, "\n"), Canvas: canvas}
+	}
+	return pages, nil
+}
+
+func (g *Grid) drawTile(dc *gg.Context, k int, v *Item, img image.Image, x float64, y float64, width float64, height float64) {
+	labelBackground := color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	labelForeground := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	if v.Guessed {
+		labelBackground = color.RGBA{R: 0, G: 255, B: 0, A: 255}
+		labelForeground = color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	}
+	if v.Revealed {
+		labelBackground = revealedColor
+	}
+
+	captionSize := g.LabelSize * 0.8
+	dc.DrawImage(img, int(x), int(y))
+	switch {
+	case v.Revealed:
+		drawCaption(dc, v.Answer, "", revealedColor, x, y, width, height, captionSize)
+	case v.Guessed:
+		drawCaption(dc, v.Answer, v.GuessedBy, color.White, x, y, width, height, captionSize)
+	default:
+		drawGuessedParts(dc, v.Parts, x, y, width, height, captionSize)
+	}
+
+	labelWidth, labelHeight := g.LabelSize*1.75, g.LabelSize*1.5
+	dc.SetColor(labelBackground)
+	dc.DrawRectangle(x, y, labelWidth, labelHeight)
+	dc.Fill()
+
+	dc.SetColor(labelForeground)
+	dc.SetFontFace(truetype.NewFace(font, &truetype.Options{Size: g.LabelSize}))
+	dc.DrawStringAnchored(fmt.Sprintf("%d", k+1), x+labelWidth/2, y+labelHeight/2, 0.5, 0.35)
+}
+
+// Crossword draws the crossword next to the first page of the grid. The remaining pages just have the grid.
+type Crossword struct {
+	Grid *Grid
+	// Size is the width and height of the crossword in pixels.
+	Size int
+}
+
+func (c *Crossword) Render(imagesDir string, state *State) ([]*render.Page, error) {
+	pages, err := c.Grid.Render(imagesDir, state)
+	if err != nil || state.Crossword == nil {
+		return pages, err
+	}
+
+	crosswordCtx, err := crossword.RenderPNG(state.Crossword, c.Size, c.Size)
+	if err != nil {
+		return nil, err
+	}
+	gridCtx := pages[0].Canvas
+	dc := gg.NewContext(gridCtx.Width()+c.Size, max(c.Size, gridCtx.Height()))
+	dc.SetColor(color.Black)
+	dc.Clear()
+	dc.DrawImage(gridCtx.Image(), 0, 0)
+	dc.DrawImage(crosswordCtx.Image(), gridCtx.Width(), 0)
+	pages[0].Canvas = dc
+	for _, v := range state.Crossword.Words {
+		pages[0].Key += fmt.Sprintf("\n%d:%v", v.ID, v.Solved)
+	}
+	return pages, nil
+}
+
+// tileKey changes whenever the item would be drawn differently.
+func tileKey(imagePath string, v *Item) string {
+	var parts []string
+	for _, part := range v.Parts {
+		if part.Guessed {
+			parts = append(parts, part.String())
+		}
+	}
+	return fmt.Sprintf("%s|%v|%v|%s|%s|%s", imagePath, v.Guessed, v.Revealed, v.GuessedBy, v.Answer, strings.Join(parts, "/"))
+}
+
+func drawGuessedParts(dc *gg.Context, parts []*AnswerPart, x float64, y float64, width float64, height float64, fontSize float64) {
+	var guessed []string
+	for _, v := range parts {
+		if v.Guessed {
+			guessed = append(guessed, v.String())
+		}
+	}
+	if len(guessed) == 0 {
+		return
+	}
+	barHeight := fontSize * 1.6
+	dc.SetColor(color.RGBA{R: 0, G: 0, B: 0, A: 200})
+	dc.DrawRectangle(x, y+height-barHeight, width, barHeight)
+	dc.Fill()
+
+	dc.SetColor(color.RGBA{R: 255, G: 200, B: 0, A: 255})
+	dc.SetFontFace(truetype.NewFace(font, &truetype.Options{Size: fontSize}))
+	dc.DrawStringAnchored(strings.Join(guessed, " / "), x+width/2, y+height-barHeight/2, 0.5, 0.35)
+}
+
+// drawCaption draws the answer and the user that guessed it in a bar at the bottom of the item.
+func drawCaption(dc *gg.Context, answer string, guessedBy string, answerColor color.Color, x float64, y float64, width float64, height float64, fontSize float64) {
+	lines := 1.0
+	if guessedBy != "" {
+		lines = 2
+	}
+	barHeight := fontSize*1.4*lines + fontSize*0.4
+	dc.SetColor(color.RGBA{R: 0, G: 0, B: 0, A: 200})
+	dc.DrawRectangle(x, y+height-barHeight, width, barHeight)
+	dc.Fill()
+
+	dc.SetFontFace(truetype.NewFace(font, &truetype.Options{Size: fontSize}))
+	dc.SetColor(answerColor)
+	dc.DrawStringAnchored(fitText(dc, answer, width-fontSize/2), x+width/2, y+height-barHeight+fontSize*0.9, 0.5, 0.35)
+	if guessedBy != "" {
+		dc.SetColor(color.RGBA{R: 180, G: 180, B: 180, A: 255})
+		dc.DrawStringAnchored(fitText(dc, guessedBy, width-fontSize/2), x+width/2, y+height-barHeight+fontSize*2.3, 0.5, 0.35)
+	}
+}
+
+// fitText shortens the text with an ellipsis until it fits in the given width using the current font.
+func fitText(dc *gg.Context, text string, width float64) string {
+	if w, _ := dc.MeasureString(text); w <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if w, _ := dc.MeasureString(string(runes) + "…"); w <= width {
+			break
+		}
+	}
+	return string(runes) + "…"
+}
@@ -0,0 +1,42 @@
+package picturequiz
+
+import "fmt"
+
+// Rule can refuse a guess before it is checked.
+type Rule interface {
+	// Allow returns false if the user may not guess right now.
+	Allow(s *State, user string) bool
+	// Description explains the rule to players (empty to leave it out of the game description).
+	Description() string
+}
+
+// AlternateUsers prevents the user that answered the last item from answering the next one.
+type AlternateUsers struct {
+	// MinUnsolved turns the rule off once this many or fewer items are left (0 to always apply it).
+	MinUnsolved int
+}
+
+func (r AlternateUsers) Allow(s *State, user string) bool {
+	if s.Scores == nil || s.Scores.LastUser != user {
+		return true
+	}
+	return r.MinUnsolved > 0 && s.NumUnsolved() <= r.MinUnsolved
+}
+
+func (r AlternateUsers) Description() string {
+	if r.MinUnsolved > 0 {
+		return fmt.Sprintf("Guessing must alternate between users until %d or fewer are left. You cannot answer several in a row.", r.MinUnsolved)
+	}
+	return "Guessing must alternate between users. You cannot answer several in a row."
+}
+
+// HostCannotGuess prevents the player that submitted the game from guessing since they know the answers.
+type HostCannotGuess struct{}
+
+func (r HostCannotGuess) Allow(s *State, user string) bool {
+	return s.Host == "" || s.Host != user
+}
+
+func (r HostCannotGuess) Description() string {
+	return ""
+}
@@ -0,0 +1,363 @@
+package picturequiz
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/warmans/gamesmaster/pkg/clues"
+	"github.com/warmans/gamesmaster/pkg/manifest"
+	"github.com/warmans/gamesmaster/pkg/obscure"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+	"github.com/warmans/gamesmaster/pkg/render"
+	"github.com/warmans/gamesmaster/pkg/scores"
+	"github.com/warmans/gamesmaster/pkg/util"
+	"github.com/warmans/go-crossword/v2"
+)
+
+// DefaultDuration is used if the game has no duration and the variant has no default.
+const DefaultDuration = time.Hour * 24
+
+type Config struct {
+	// ImagesWidth and ImagesHeight are the size of each item on the board (0 for the renderer's default).
+	ImagesWidth  int64
+	ImagesHeight int64
+	// RequireAlternatingUsers prevents the same user answering several items in a row.
+	RequireAlternatingUsers bool
+	Matcher                 *util.Matcher
+	// CloseFeedback enables reactions for guesses that were close or partially right.
+	CloseFeedback bool
+	// WrongGuessesPerStage reveals the next stage of an item after this many wrong guesses (0 to only reveal over time).
+	WrongGuessesPerStage int
+	// ClueLadder is the clues given when players ask for them. It is set to the variant's default when the game is
+	// loaded if empty.
+	ClueLadder clues.Ladder
+	// MaxBoardWidth and MaxBoardHeight limit the size of each page of the board in pixels (0 for the default).
+	MaxBoardWidth  int
+	MaxBoardHeight int
+	// MaxBoardFileSize limits the size of each encoded page in bytes (0 for the default).
+	MaxBoardFileSize int
+	// GuessLimits replaces the bot's guess limits for this game. It is set to the variant's default when the game is
+	// loaded if nil.
+	GuessLimits *ratelimit.Override
+}
+
+// GuessMatcher returns the configured answer matcher. A nil matcher uses the defaults.
+func (c *Config) GuessMatcher() *util.Matcher {
+	if c == nil {
+		return nil
+	}
+	return c.Matcher
+}
+
+// GuessLimitOverride returns the game's guess limits or nil to use the bot's limits.
+func (c *Config) GuessLimitOverride() *ratelimit.Override {
+	if c == nil {
+		return nil
+	}
+	return c.GuessLimits
+}
+
+func (c *Config) CloseFeedbackEnabled() bool {
+	return c != nil && c.CloseFeedback
+}
+
+func (c *Config) wrongGuessesPerStage() int {
+	if c == nil {
+		return 0
+	}
+	return c.WrongGuessesPerStage
+}
+
+// BoardFileSize returns the maximum size of each encoded page of the board.
+func (c *Config) BoardFileSize() int {
+	if c == nil || c.MaxBoardFileSize <= 0 {
+		return render.DefaultMaxFileSize
+	}
+	return c.MaxBoardFileSize
+}
+
+type State struct {
+	GameTitle              string
+	Cfg                    *Config
+	GuildID                string
+	OriginalMessageID      string
+	OriginalMessageChannel string
+	AnswerThreadID         string
+	Posters                []*Item
+	// Crossword is only set for variants that show the answers in a crossword.
+	Crossword *crossword.Crossword
+	Scores    *scores.Tiered
+	StartedAt time.Time
+	// Duration is how long the game runs once it has started.
+	Duration time.Duration
+	// EndsAt is when the game ends. It is set when the game starts unless a fixed end time was given.
+	EndsAt time.Time
+	// PageMessageIDs are the messages showing the pages of the board after the first, which is shown in the
+	// original message.
+	PageMessageIDs []string
+	// Host submitted the images and is not allowed to guess (empty if the game was created by an admin).
+	Host string
+}
+
+// NewStateFromManifest creates a game with an item for each image in the manifest, or in the images dir if there is
+// no manifest, in a random order. Generated obscured images and reveal stages are not used as items.
+func NewStateFromManifest(v *Variant, imagesDir string, manifestPath string) (*State, error) {
+	items, err := manifest.Items(imagesDir, manifestPath, func(fileName string) bool {
+		return obscure.IsObscured(fileName) || obscure.IsStage(fileName)
+	})
+	if err != nil {
+		return nil, err
+	}
+	state := &State{Posters: make([]*Item, 0, len(items))}
+	for _, item := range items {
+		state.Posters = append(state.Posters, NewItem(item, v.Obscured))
+	}
+	rand.Shuffle(len(state.Posters), func(i, j int) {
+		state.Posters[i], state.Posters[j] = state.Posters[j], state.Posters[i]
+	})
+	state.Scores = scores.NewTiered(len(state.Posters))
+	return state, nil
+}
+
+// ObscuredImages lists the items that have an obscured copy of their image.
+func (s *State) ObscuredImages() []obscure.Image {
+	var images []obscure.Image
+	for _, v := range s.Posters {
+		if v.ObscuredImage != "" {
+			images = append(images, obscure.Image{Original: v.OriginalImage, Obscured: v.ObscuredImage})
+		}
+	}
+	return images
+}
+
+// UnmarshalJSON also reads crossfilm games saved before the games shared an engine.
+func (s *State) UnmarshalJSON(data []byte) error {
+	type state State
+	legacy := struct {
+		*state
+		FilmgameState  []*Item
+		CrosswordState *crossword.Crossword
+	}{state: (*state)(s)}
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	if len(s.Posters) == 0 {
+		s.Posters = legacy.FilmgameState
+	}
+	if s.Crossword == nil {
+		s.Crossword = legacy.CrosswordState
+	}
+	return nil
+}
+
+func (s *State) NumUnsolved() int {
+	numUnsolved := len(s.Posters)
+	for _, v := range s.Posters {
+		if v.Guessed {
+			numUnsolved--
+		}
+	}
+	return numUnsolved
+}
+
+// Complete returns true if the game has started and every item was guessed or revealed.
+func (s *State) Complete() bool {
+	return !s.StartedAt.IsZero() && s.NumUnsolved() == 0
+}
+
+// Item returns the item with the number shown on the board (starting from 1) or nil if there is no such item.
+func (s *State) Item(number string) *Item {
+	for k, v := range s.Posters {
+		if fmt.Sprintf("%d", k+1) == number {
+			return v
+		}
+	}
+	return nil
+}
+
+// GuessOutcome is the result of checking a guess.
+type GuessOutcome struct {
+	Result util.GuessResult
+	// Correct is true if the guess completed the item.
+	Correct bool
+	// PartCorrect is true if the guess was one part of an answer with several parts.
+	PartCorrect   bool
+	AlreadySolved bool
+	// Complete is true if every item has been guessed.
+	Complete     bool
+	StageChanged bool
+}
+
+// Guess checks the user's guess at the numbered item and updates the item, crossword and scores.
+func (s *State) Guess(number string, user string, guess string) GuessOutcome {
+	out := GuessOutcome{Result: util.GuessWrong}
+	item := s.Item(number)
+	if item == nil {
+		return out
+	}
+	matcher := s.Cfg.GuessMatcher()
+
+	out.Result = item.Classify(matcher, guess)
+	if out.Result != util.GuessCorrect && !item.Guessed {
+		item.WrongGuesses++
+	}
+	if out.Result == util.GuessCorrect {
+		if item.Guessed {
+			out.AlreadySolved = true
+			return out
+		}
+		// a guess at one part of a multi-part answer only completes the item if it was the last part
+		if part := item.MatchPart(matcher, guess); part != nil && !item.IsCorrect(matcher, guess) {
+			if part.Guessed {
+				out.AlreadySolved = true
+				return out
+			}
+			part.Guessed = true
+			out.PartCorrect = true
+		}
+		if !out.PartCorrect || item.AllPartsGuessed() {
+			item.SetGuessed(user)
+			s.solveCrossword(number)
+			out.Correct = true
+		}
+	}
+	out.Complete = s.NumUnsolved() == 0
+
+	if out.Correct {
+		s.Scores.Add(user)
+	} else if out.PartCorrect {
+		s.Scores.AddPartial(user)
+	} else {
+		out.StageChanged = s.AdvanceStages()
+	}
+	return out
+}
+
+// Reveal gives away all the unguessed items at the end of the game.
+func (s *State) Reveal() {
+	for _, v := range s.Posters {
+		v.Reveal()
+	}
+	if s.Crossword != nil {
+		for k := range s.Crossword.Words {
+			s.Crossword.Words[k].Solved = true
+		}
+	}
+}
+
+// solveCrossword shows the answer of the numbered item in the crossword.
+func (s *State) solveCrossword(number string) {
+	if s.Crossword == nil {
+		return
+	}
+	for k, v := range s.Crossword.Words {
+		// use the label to avoid having to strip spaces from the crossword answers
+		if v.Word.Label != nil && *v.Word.Label == number {
+			s.Crossword.Words[k].Solved = true
+		}
+	}
+}
+
+// RequestClue records the player's request and returns the clue text or false if no clues are unlocked yet.
+func (s *State) RequestClue(item *Item, player string) (string, bool) {
+	var ladder clues.Ladder
+	if s.Cfg != nil {
+		ladder = s.Cfg.ClueLadder
+	}
+	step, text := ladder.Next(
+		clues.Progress{
+			Elapsed:  time.Since(s.StartedAt),
+			Unsolved: s.NumUnsolved(),
+			Requests: item.ClueUsage.Record(player),
+		},
+		item.Answer,
+		item.Clues,
+	)
+	if step < 0 {
+		return "", false
+	}
+	item.ClueUsage.Given(step)
+	return text, true
+}
+
+// AdvanceStages moves any unguessed items to their current reveal stage. True is returned if any stage changed.
+func (s *State) AdvanceStages() bool {
+	if s.StartedAt.IsZero() {
+		return false
+	}
+	changed := false
+	for _, v := range s.Posters {
+		if v.Guessed {
+			continue
+		}
+		if stage := v.RevealStage(time.Since(s.StartedAt), s.TotalDuration(), s.Cfg.wrongGuessesPerStage()); stage != v.Stage {
+			v.Stage = stage
+			changed = true
+		}
+	}
+	return changed
+}
+
+// GameDuration returns the configured duration or the default e.g. for games created before it was configurable.
+func (s *State) GameDuration() time.Duration {
+	if s.Duration <= 0 {
+		return DefaultDuration
+	}
+	return s.Duration
+}
+
+// Start marks the game as started. A non-zero duration replaces the configured duration and end time.
+func (s *State) Start(now time.Time, duration time.Duration) {
+	s.StartedAt = now
+	if duration > 0 {
+		s.Duration = duration
+		s.EndsAt = time.Time{}
+	}
+	if s.EndsAt.IsZero() {
+		s.EndsAt = now.Add(s.GameDuration())
+	}
+}
+
+// EndTime returns when the game ends. Games that have not started and have no fixed end time return a zero time.
+func (s *State) EndTime() time.Time {
+	if !s.EndsAt.IsZero() {
+		return s.EndsAt
+	}
+	if s.StartedAt.IsZero() {
+		return time.Time{}
+	}
+	return s.StartedAt.Add(s.GameDuration())
+}
+
+// TimeLeft returns how long players have left to complete the game.
+func (s *State) TimeLeft() time.Duration {
+	end := s.EndTime()
+	if end.IsZero() {
+		return s.GameDuration()
+	}
+	return max(0, time.Until(end))
+}
+
+// TotalDuration returns the time between the start and end of the game.
+func (s *State) TotalDuration() time.Duration {
+	if s.StartedAt.IsZero() || s.EndTime().IsZero() {
+		return s.GameDuration()
+	}
+	return s.EndTime().Sub(s.StartedAt)
+}
+
+// Expired returns true if the game has started and run out of time.
+func (s *State) Expired() bool {
+	return !s.StartedAt.IsZero() && !time.Now().Before(s.EndTime())
+}
+
+// Extend moves the end of the game by the given amount. Negative amounts shorten the game.
+func (s *State) Extend(by time.Duration) {
+	if s.EndTime().IsZero() {
+		s.Duration = max(time.Minute, s.GameDuration()+by)
+		return
+	}
+	s.EndsAt = s.EndTime().Add(by)
+}
@@ -0,0 +1,153 @@
+package picturequiz
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/warmans/gamesmaster/pkg/clues"
+	"github.com/warmans/gamesmaster/pkg/ratelimit"
+)
+
+// Variant configures one picture quiz game e.g. the film poster game. The engine and discord command are shared so
+// new variants only need a name, a renderer and their rules.
+type Variant struct {
+	// Name is the root command and the directory the game is stored under e.g. var/<name>/game.
+	Name string
+	// Description is shown in the list of discord commands.
+	Description string
+	// Subject is what players are guessing e.g. "posters".
+	Subject string
+	// BoardName is the file name of the uploaded board.
+	BoardName string
+	// PerGuild stores a separate game for each guild rather than one game shared by all guilds.
+	PerGuild bool
+	// Submissions lets trusted players upload games from discord.
+	Submissions bool
+	// Obscured shows an obscured copy of each image (e.g. fargo.blur.jpg) until it is guessed.
+	Obscured bool
+	// DefaultDuration is used if the game has no duration.
+	DefaultDuration time.Duration
+	// DefaultClueLadder is used if the game config has no ladder (empty to disable clues).
+	DefaultClueLadder clues.Ladder
+	// DefaultGuessLimits replace the bot's guess limits if the game config has none (nil to use the bot's limits).
+	DefaultGuessLimits *ratelimit.Override
+	// DefaultConfig is used for games without a config e.g. games submitted by players.
+	DefaultConfig Config
+	Renderer      Renderer
+	// Rules returns the extra rules for a game with the given config. Rules are checked in order before each guess.
+	Rules func(cfg *Config) []Rule
+}
+
+// GameDir is where the game state is stored.
+func (v *Variant) GameDir() string {
+	return path.Join("var", v.Name, "game")
+}
+
+// ImagesDir is where the game images are stored. Item images are relative to this dir.
+func (v *Variant) ImagesDir() string {
+	return "./" + path.Join(v.GameDir(), "images")
+}
+
+// QueueDir is where submitted games wait to be started.
+func (v *Variant) QueueDir() string {
+	return path.Join("var", v.Name, "queue")
+}
+
+// StateFile is the path of the game state for the given guild.
+func (v *Variant) StateFile(guildID string) string {
+	if v.PerGuild {
+		return path.Join(v.GameDir(), fmt.Sprintf("%s.json", path.Base(guildID)))
+	}
+	return path.Join(v.GameDir(), "current.json")
+}
+
+// Prepare fills in the variant defaults for a loaded game.
+func (v *Variant) Prepare(s *State) {
+	if s.Cfg == nil {
+		cfg := v.DefaultConfig
+		s.Cfg = &cfg
+	}
+	if s.Duration <= 0 && v.DefaultDuration > 0 {
+		s.Duration = v.DefaultDuration
+	}
+	if len(s.Cfg.ClueLadder) == 0 {
+		s.Cfg.ClueLadder = v.DefaultClueLadder
+	}
+	if s.Cfg.GuessLimits == nil {
+		s.Cfg.GuessLimits = v.DefaultGuessLimits
+	}
+}
+
+// GameRules returns all the rules that apply to the game.
+func (v *Variant) GameRules(s *State) []Rule {
+	rules := []Rule{HostCannotGuess{}}
+	if v.Rules != nil {
+		rules = append(rules, v.Rules(s.Cfg)...)
+	}
+	return rules
+}
+
+// AllowGuess returns false if any of the game rules refuse the user's guess.
+func (v *Variant) AllowGuess(s *State, user string) bool {
+	for _, r := range v.GameRules(s) {
+		if !r.Allow(s, user) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filmgame is guessing films from their posters, one game shared by all guilds.
+var Filmgame = &Variant{
+	Name:            "filmgame",
+	Description:     "Film poster game",
+	Subject:         "posters",
+	BoardName:       "Filmgame",
+	Obscured:        true,
+	DefaultDuration: time.Hour * 24,
+	DefaultClueLadder: clues.Ladder{
+		{Type: clues.StepFirstLetter, MaxUnsolved: 5},
+		{Type: clues.StepInitials, MaxUnsolved: 5, After: time.Hour * 12},
+	},
+	Renderer: &Grid{Columns: 5, TileWidth: 200, TileHeight: 300, LabelSize: 20},
+	Rules: func(cfg *Config) []Rule {
+		return []Rule{AlternateUsers{}}
+	},
+}
+
+// ImageGame is guessing any kind of image with a separate game per guild. Games can be submitted by players.
+var ImageGame = &Variant{
+	Name:            "imagegame",
+	Description:     "Image Game",
+	Subject:         "images",
+	BoardName:       "imagegame",
+	PerGuild:        true,
+	Submissions:     true,
+	DefaultDuration: time.Hour * 24 * 7,
+	DefaultClueLadder: clues.Ladder{
+		{Type: clues.StepInitials, MaxUnsolved: 5},
+	},
+	DefaultConfig: Config{ImagesWidth: 200, ImagesHeight: 300},
+	Renderer:      &Grid{Columns: 8, TileWidth: 200, TileHeight: 300, LabelSize: 30},
+	Rules: func(cfg *Config) []Rule {
+		if cfg != nil && cfg.RequireAlternatingUsers {
+			return []Rule{AlternateUsers{MinUnsolved: 3}}
+		}
+		return nil
+	},
+}
+
+// Crossfilm is guessing films from their posters with the answers filled into a crossword.
+var Crossfilm = &Variant{
+	Name:            "crossfilm",
+	Description:     "Crossword/Filmposter game",
+	Subject:         "posters",
+	BoardName:       "Crossfilm",
+	Obscured:        true,
+	DefaultDuration: time.Hour * 24,
+	Renderer: &Crossword{
+		Grid: &Grid{Columns: 5, TileWidth: 200, TileHeight: 300, MaxWidth: 1000, MaxHeight: 1800, LabelSize: 20},
+		Size: 1000,
+	},
+}
@@ -0,0 +1,259 @@
+package puzzle
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/warmans/go-crossword/v2"
+)
+
+type ExportOptions struct {
+	Title string
+	// IncludeSolution adds the full solution to the export.
+	IncludeSolution bool
+	// ShowSolved fills in the letters that have been revealed in the game so far.
+	ShowSolved bool
+}
+
+// exportGrid is the part of a crossword grid that contains words, so empty space around generated
+// crosswords is not exported.
+type exportGrid struct {
+	cw       *crossword.Crossword
+	minX     int
+	minY     int
+	width    int
+	height   int
+	revealed map[[2]int]bool
+	// labels are the numbers of the cells that start a word.
+	labels map[[2]int]string
+}
+
+func newExportGrid(cw *crossword.Crossword) *exportGrid {
+	g := &exportGrid{cw: cw, minX: len(cw.Grid), minY: len(cw.Grid), revealed: map[[2]int]bool{}, labels: map[[2]int]string{}}
+	maxX, maxY := -1, -1
+	for y, row := range cw.Grid {
+		for x, cell := range row {
+			if cell.Empty() {
+				continue
+			}
+			g.minX, g.minY = min(g.minX, x), min(g.minY, y)
+			maxX, maxY = max(maxX, x), max(maxY, y)
+			for _, pl := range cw.CellPlacements(x, y) {
+				if pl.Solved || slices.Contains(pl.Word.CharacterHints, cell.CharIdx) {
+					g.revealed[[2]int{x, y}] = true
+				}
+			}
+		}
+	}
+	if maxX < 0 {
+		g.minX, g.minY = 0, 0
+	}
+	g.width, g.height = maxX-g.minX+1, maxY-g.minY+1
+
+	// start cells are numbered in reading order the same as a printed crossword. The IDs of generated words
+	// cannot be used since an across and down word starting in the same cell may have different IDs.
+	var starts [][2]int
+	for _, pl := range cw.Words {
+		if key := [2]int{pl.X, pl.Y}; !slices.Contains(starts, key) {
+			starts = append(starts, key)
+		}
+	}
+	slices.SortFunc(starts, compareCells)
+	for k, key := range starts {
+		g.labels[key] = strconv.Itoa(k + 1)
+	}
+	return g
+}
+
+// compareCells orders cells from left to right then top to bottom.
+func compareCells(a, b [2]int) int {
+	if a[1] != b[1] {
+		return a[1] - b[1]
+	}
+	return a[0] - b[0]
+}
+
+// cell returns the cell at the given position relative to the exported area.
+func (g *exportGrid) cell(x, y int) (crossword.Cell, bool) {
+	cell := g.cw.Grid[y+g.minY][x+g.minX]
+	return cell, g.revealed[[2]int{x + g.minX, y + g.minY}]
+}
+
+func (g *exportGrid) label(x, y int) string {
+	return g.labels[[2]int{x + g.minX, y + g.minY}]
+}
+
+// clues returns the clues in one direction ordered by their number.
+func (g *exportGrid) clues(vertical bool) []crossword.Placement {
+	var clues []crossword.Placement
+	for _, pl := range g.cw.Words {
+		if pl.Vertical == vertical {
+			clues = append(clues, pl)
+		}
+	}
+	slices.SortStableFunc(clues, func(a, b crossword.Placement) int {
+		return compareCells([2]int{a.X, a.Y}, [2]int{b.X, b.Y})
+	})
+	return clues
+}
+
+// clueLabel is the number of the cell the word starts in.
+func (g *exportGrid) clueLabel(pl crossword.Placement) string {
+	return g.labels[[2]int{pl.X, pl.Y}]
+}
+
+func clueText(pl crossword.Placement) string {
+	return fmt.Sprintf("%s (%s)", pl.Word.Clue, pl.Word.LetterCountStr())
+}
+
+// WriteIPuz writes the crossword in the ipuz format. Cells that are not part of any word are omitted (null).
+func WriteIPuz(w io.Writer, cw *crossword.Crossword, opts ExportOptions) error {
+	g := newExportGrid(cw)
+
+	out := map[string]any{
+		"version":    "http://ipuz.org/v2",
+		"kind":       []string{"http://ipuz.org/crossword#1"},
+		"title":      opts.Title,
+		"dimensions": map[string]int{"width": g.width, "height": g.height},
+		"block":      "#",
+	}
+	puzzleGrid := make([][]any, g.height)
+	solutionGrid := make([][]any, g.height)
+	savedGrid := make([][]any, g.height)
+	for y := range g.height {
+		puzzleGrid[y], solutionGrid[y], savedGrid[y] = make([]any, g.width), make([]any, g.width), make([]any, g.width)
+		for x := range g.width {
+			cell, revealed := g.cell(x, y)
+			if cell.Empty() {
+				continue
+			}
+			puzzleGrid[y][x] = 0
+			if label := g.label(x, y); label != "" {
+				puzzleGrid[y][x] = label
+			}
+			solutionGrid[y][x] = strings.ToUpper(cell.String())
+			savedGrid[y][x] = ""
+			if revealed {
+				savedGrid[y][x] = strings.ToUpper(cell.String())
+			}
+		}
+	}
+	out["puzzle"] = puzzleGrid
+	if opts.IncludeSolution {
+		out["solution"] = solutionGrid
+	}
+	if opts.ShowSolved {
+		out["saved"] = savedGrid
+	}
+
+	clues := map[string][]any{}
+	for direction, vertical := range map[string]bool{"Across": false, "Down": true} {
+		for _, pl := range g.clues(vertical) {
+			var cells [][]int
+			for i := range len(pl.Word.Word) {
+				// ipuz cell coordinates start at 1
+				if vertical {
+					cells = append(cells, []int{pl.X - g.minX + 1, pl.Y - g.minY + i + 1})
+				} else {
+					cells = append(cells, []int{pl.X - g.minX + i + 1, pl.Y - g.minY + 1})
+				}
+			}
+			clues[direction] = append(clues[direction], map[string]any{
+				"number":      g.clueLabel(pl),
+				"clue":        pl.Word.Clue,
+				"enumeration": pl.Word.LetterCountStr(),
+				"cells":       cells,
+			})
+		}
+	}
+	out["clues"] = clues
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+const (
+	svgWidth         = 800.0
+	svgMargin        = 40.0
+	svgMaxCellSize   = 40.0
+	svgTitleSize     = 24.0
+	svgClueSize      = 13.0
+	svgClueLineSpace = 18.0
+)
+
+// WriteSVG writes a printable version of the crossword with the grid followed by numbered clue lists.
+func WriteSVG(w io.Writer, cw *crossword.Crossword, opts ExportOptions) error {
+	g := newExportGrid(cw)
+
+	cellSize := min(svgMaxCellSize, (svgWidth-svgMargin*2)/float64(max(g.width, 1)))
+	gridTop := svgMargin + svgTitleSize*2
+	gridLeft := (svgWidth - cellSize*float64(g.width)) / 2
+	cluesTop := gridTop + cellSize*float64(g.height) + svgMargin
+
+	columnWidth := (svgWidth - svgMargin*3) / 2
+	across := g.clueLines(false, columnWidth)
+	down := g.clueLines(true, columnWidth)
+	height := cluesTop + float64(max(len(across), len(down))+2)*svgClueLineSpace + svgMargin
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="sans-serif">`+"\n", svgWidth, height, svgWidth, height)
+	fmt.Fprintf(sb, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+	fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" font-size="%.0f" text-anchor="middle" font-weight="bold">%s</text>`+"\n", svgWidth/2, svgMargin+svgTitleSize/2, svgTitleSize, html.EscapeString(opts.Title))
+
+	for y := range g.height {
+		for x := range g.width {
+			cell, revealed := g.cell(x, y)
+			if cell.Empty() {
+				continue
+			}
+			left, top := gridLeft+float64(x)*cellSize, gridTop+float64(y)*cellSize
+			fmt.Fprintf(sb, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="white" stroke="black"/>`+"\n", left, top, cellSize, cellSize)
+			if label := g.label(x, y); label != "" {
+				fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" font-size="%.1f">%s</text>`+"\n", left+2, top+cellSize*0.3, cellSize*0.28, html.EscapeString(label))
+			}
+			if opts.IncludeSolution || (opts.ShowSolved && revealed) {
+				fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" font-size="%.1f" text-anchor="middle">%s</text>`+"\n", left+cellSize/2, top+cellSize*0.8, cellSize*0.6, html.EscapeString(strings.ToUpper(cell.String())))
+			}
+		}
+	}
+
+	for column, lines := range [][]string{append([]string{"Across"}, across...), append([]string{"Down"}, down...)} {
+		left := svgMargin + float64(column)*(columnWidth+svgMargin)
+		for k, line := range lines {
+			weight := "normal"
+			if k == 0 {
+				weight = "bold"
+			}
+			fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" font-size="%.0f" font-weight="%s">%s</text>`+"\n", left, cluesTop+float64(k)*svgClueLineSpace, svgClueSize, weight, html.EscapeString(line))
+		}
+	}
+	sb.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// clueLines wraps the clues in one direction to fit in a column. The width of the characters is estimated since
+// the font is chosen by whatever displays the SVG.
+func (g *exportGrid) clueLines(vertical bool, columnWidth float64) []string {
+	maxChars := int(columnWidth / (svgClueSize * 0.55))
+	var lines []string
+	for _, pl := range g.clues(vertical) {
+		line := g.clueLabel(pl) + "."
+		for _, word := range strings.Fields(clueText(pl)) {
+			if len(line)+len(word)+1 > maxChars {
+				lines = append(lines, line)
+				line = "    "
+			}
+			line += " " + word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,186 @@
+package puzzle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ipuz is the subset of the ipuz crossword format needed to play a puzzle.
+// See: http://www.ipuz.org/
+type ipuz struct {
+	Kind       []string `json:"kind"`
+	Title      string   `json:"title"`
+	Author     string   `json:"author"`
+	Block      *string  `json:"block"`
+	Dimensions struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"dimensions"`
+	Puzzle   [][]json.RawMessage          `json:"puzzle"`
+	Solution [][]json.RawMessage          `json:"solution"`
+	Clues    map[string][]json.RawMessage `json:"clues"`
+}
+
+func LoadIPuz(filePath string) (*Puzzle, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadIPuz(f)
+}
+
+// ReadIPuz reads an ipuz crossword. The puzzle must include the solution.
+func ReadIPuz(r io.Reader) (*Puzzle, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// files are sometimes wrapped like ipuz({...})
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("ipuz(")) {
+		data = bytes.TrimSuffix(bytes.TrimPrefix(data, []byte("ipuz(")), []byte(")"))
+	}
+
+	raw := &ipuz{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return nil, fmt.Errorf("failed to decode ipuz: %w", err)
+	}
+	if len(raw.Kind) > 0 && !strings.Contains(raw.Kind[0], "crossword") {
+		return nil, fmt.Errorf("unsupported ipuz kind: %s", raw.Kind[0])
+	}
+	if raw.Solution == nil {
+		return nil, errors.New("ipuz file has no solution")
+	}
+	block := "#"
+	if raw.Block != nil {
+		block = *raw.Block
+	}
+
+	p := &Puzzle{
+		Title:  raw.Title,
+		Author: raw.Author,
+		Width:  raw.Dimensions.Width,
+		Height: raw.Dimensions.Height,
+	}
+	for y, row := range raw.Solution {
+		solutionRow := make([]rune, len(row))
+		for x, cell := range row {
+			if solutionRow[x], err = ipuzSolutionCell(cell, block); err != nil {
+				return nil, fmt.Errorf("invalid solution cell at %d,%d: %w", x+1, y+1, err)
+			}
+		}
+		p.Solution = append(p.Solution, solutionRow)
+	}
+	if raw.Puzzle != nil {
+		for _, row := range raw.Puzzle {
+			numbersRow := make([]int, p.Width)
+			for x, cell := range row {
+				if x < p.Width {
+					numbersRow[x] = ipuzCellNumber(cell)
+				}
+			}
+			p.Numbers = append(p.Numbers, numbersRow)
+		}
+		if len(p.Numbers) != p.Height {
+			return nil, fmt.Errorf("expected %d puzzle rows got %d", p.Height, len(p.Numbers))
+		}
+	}
+
+	for direction, clues := range raw.Clues {
+		// direction may also have a display name e.g. Across:Across
+		vertical := false
+		switch strings.ToLower(strings.SplitN(direction, ":", 2)[0]) {
+		case "across":
+		case "down":
+			vertical = true
+		default:
+			return nil, fmt.Errorf("unsupported clue direction: %s", direction)
+		}
+		for _, rawClue := range clues {
+			clue, err := ipuzClue(rawClue, vertical)
+			if err != nil {
+				return nil, err
+			}
+			p.Clues = append(p.Clues, clue)
+		}
+	}
+	return p, nil
+}
+
+func ipuzSolutionCell(raw json.RawMessage, block string) (rune, error) {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return Block, err
+	}
+	if obj, ok := value.(map[string]any); ok {
+		value = obj["value"]
+	}
+	str, ok := value.(string)
+	if !ok || str == "" || str == block {
+		return Block, nil
+	}
+	if utf8.RuneCountInString(str) != 1 {
+		return Block, fmt.Errorf("rebus squares are not supported: %s", str)
+	}
+	r, _ := utf8.DecodeRuneInString(str)
+	return r, nil
+}
+
+func ipuzCellNumber(raw json.RawMessage) int {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0
+	}
+	if obj, ok := value.(map[string]any); ok {
+		value = obj["cell"]
+	}
+	return anyToInt(value)
+}
+
+func ipuzClue(raw json.RawMessage, vertical bool) (Clue, error) {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return Clue{}, err
+	}
+	switch v := value.(type) {
+	case []any:
+		// e.g. [1, "Some clue"]
+		if len(v) != 2 {
+			return Clue{}, fmt.Errorf("invalid clue: %s", string(raw))
+		}
+		text, _ := v[1].(string)
+		return parseClueText(anyToInt(v[0]), vertical, text), nil
+	case map[string]any:
+		// e.g. {"number": 1, "clue": "Some clue", "enumeration": "3,4"}
+		text, _ := v["clue"].(string)
+		clue := parseClueText(anyToInt(v["number"]), vertical, text)
+		if enumeration, ok := v["enumeration"].(string); ok {
+			clue.LettersCounts = parseEnumeration(enumeration)
+		}
+		return clue, nil
+	case string:
+		// e.g. "1 Some clue"
+		number, text, _ := strings.Cut(v, " ")
+		return parseClueText(anyToInt(number), vertical, text), nil
+	}
+	return Clue{}, fmt.Errorf("invalid clue: %s", string(raw))
+}
+
+func anyToInt(value any) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+	return 0
+}
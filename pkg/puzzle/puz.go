@@ -0,0 +1,169 @@
+package puzzle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Across Lite .puz header offsets.
+// See: https://code.google.com/archive/p/puz/wikis/FileFormat.wiki
+const (
+	puzMagic          = "ACROSS&DOWN\x00"
+	puzMagicOffset    = 0x02
+	puzWidthOffset    = 0x2C
+	puzHeightOffset   = 0x2D
+	puzNumCluesOffset = 0x2E
+	puzScrambledTag   = 0x32
+	puzHeaderLength   = 0x34
+	puzBlock          = '.'
+)
+
+// e.g. Some clue (3,4)
+var enumerationRegex = regexp.MustCompile(`\s*\(([0-9]+(?:[,-][0-9]+)+)\)\s*$`)
+
+func LoadPuz(filePath string) (*Puzzle, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadPuz(f)
+}
+
+// ReadPuz reads an Across Lite .puz file. Scrambled (locked) puzzles and rebus squares are not supported.
+func ReadPuz(r io.Reader) (*Puzzle, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// some files have junk before the checksum so locate the header using the magic string.
+	start := bytes.Index(data, []byte(puzMagic))
+	if start < puzMagicOffset {
+		return nil, errors.New("not a .puz file")
+	}
+	data = data[start-puzMagicOffset:]
+	if len(data) < puzHeaderLength {
+		return nil, errors.New("puz header was truncated")
+	}
+	if binary.LittleEndian.Uint16(data[puzScrambledTag:]) != 0 {
+		return nil, errors.New("scrambled puzzles are not supported")
+	}
+
+	width := int(data[puzWidthOffset])
+	height := int(data[puzHeightOffset])
+	numClues := int(binary.LittleEndian.Uint16(data[puzNumCluesOffset:]))
+
+	offset := puzHeaderLength
+	if len(data) < offset+width*height*2 {
+		return nil, errors.New("puz grid was truncated")
+	}
+	p := &Puzzle{Width: width, Height: height}
+	for y := range height {
+		row := make([]rune, width)
+		for x := range width {
+			if c := data[offset+y*width+x]; c != puzBlock {
+				row[x] = rune(c)
+			}
+		}
+		p.Solution = append(p.Solution, row)
+	}
+	// skip the solution and the player's state
+	offset += width * height * 2
+
+	readString := func() (string, error) {
+		end := bytes.IndexByte(data[offset:], 0)
+		if end < 0 {
+			return "", errors.New("puz strings were truncated")
+		}
+		str := latin1(data[offset : offset+end])
+		offset += end + 1
+		return str, nil
+	}
+	if p.Title, err = readString(); err != nil {
+		return nil, err
+	}
+	if p.Author, err = readString(); err != nil {
+		return nil, err
+	}
+	if _, err = readString(); err != nil {
+		// copyright
+		return nil, err
+	}
+
+	clueTexts := make([]string, numClues)
+	for i := range numClues {
+		if clueTexts[i], err = readString(); err != nil {
+			return nil, err
+		}
+	}
+
+	// clues are in number order with across before down when a cell starts both.
+	num := 0
+	for y := range height {
+		for x := range width {
+			if p.isBlock(x, y) {
+				continue
+			}
+			startsAcross := p.isBlock(x-1, y) && !p.isBlock(x+1, y)
+			startsDown := p.isBlock(x, y-1) && !p.isBlock(x, y+1)
+			if !startsAcross && !startsDown {
+				continue
+			}
+			num++
+			for _, vertical := range []bool{false, true} {
+				if (!vertical && !startsAcross) || (vertical && !startsDown) {
+					continue
+				}
+				if len(p.Clues) >= len(clueTexts) {
+					return nil, fmt.Errorf("expected %d clues but the grid has more answers", numClues)
+				}
+				p.Clues = append(p.Clues, parseClueText(num, vertical, clueTexts[len(p.Clues)]))
+			}
+		}
+	}
+	if len(p.Clues) != numClues {
+		return nil, fmt.Errorf("expected %d clues but the grid only has %d answers", numClues, len(p.Clues))
+	}
+	return p, nil
+}
+
+// parseClueText extracts the letter counts from clues ending in an enumeration e.g. (3,4).
+func parseClueText(number int, vertical bool, text string) Clue {
+	clue := Clue{Number: number, Vertical: vertical, Text: strings.TrimSpace(text)}
+	if match := enumerationRegex.FindStringSubmatch(text); match != nil {
+		clue.LettersCounts = parseEnumeration(match[1])
+		clue.Text = strings.TrimSpace(strings.TrimSuffix(text, match[0]))
+	}
+	return clue
+}
+
+func parseEnumeration(enumeration string) []int {
+	var counts []int
+	for _, part := range strings.FieldsFunc(enumeration, func(r rune) bool { return r == ',' || r == '-' || r == ' ' }) {
+		count, err := strconv.Atoi(part)
+		if err != nil {
+			return nil
+		}
+		counts = append(counts, count)
+	}
+	if len(counts) < 2 {
+		return nil
+	}
+	return counts
+}
+
+// latin1 converts ISO-8859-1 bytes to a string.
+func latin1(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
@@ -0,0 +1,203 @@
+package puzzle
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/warmans/gamesmaster/pkg/util"
+	"github.com/warmans/go-crossword/v2"
+)
+
+// Block is used in the solution grid for black squares.
+const Block = rune(0)
+
+// Puzzle is a hand-made crossword with a fixed grid e.g. one imported from a .puz or ipuz file.
+type Puzzle struct {
+	Title  string
+	Author string
+	Width  int
+	Height int
+	// Solution is the solution grid indexed [y][x].
+	Solution [][]rune
+	// Numbers are the clue numbers for each cell (or 0 if the cell has no number). If not set
+	// the standard numbering is used.
+	Numbers [][]int
+	Clues   []Clue
+}
+
+type Clue struct {
+	Number   int
+	Vertical bool
+	Text     string
+	// LettersCounts is the length of each word in a multi-word answer e.g. (3,4).
+	LettersCounts []int
+}
+
+// Entry is a slot in the grid along with its clue.
+type Entry struct {
+	Clue
+	X      int
+	Y      int
+	Answer string
+}
+
+func (p *Puzzle) isBlock(x, y int) bool {
+	return x < 0 || y < 0 || x >= p.Width || y >= p.Height || p.Solution[y][x] == Block
+}
+
+func (p *Puzzle) number(x, y int, standard int) int {
+	if p.Numbers != nil && p.Numbers[y][x] > 0 {
+		return p.Numbers[y][x]
+	}
+	return standard
+}
+
+// Entries returns all the answer slots in the grid, matched to their clues. An error is returned if
+// the clues and grid do not agree.
+func (p *Puzzle) Entries() ([]Entry, error) {
+	if err := p.validateGrid(); err != nil {
+		return nil, err
+	}
+	clues := map[string]Clue{}
+	for _, c := range p.Clues {
+		clues[clueKey(c.Number, c.Vertical)] = c
+	}
+
+	entries := []Entry{}
+	num := 0
+	for y := range p.Height {
+		for x := range p.Width {
+			if p.isBlock(x, y) {
+				continue
+			}
+			startsAcross := p.isBlock(x-1, y) && !p.isBlock(x+1, y)
+			startsDown := p.isBlock(x, y-1) && !p.isBlock(x, y+1)
+			if !startsAcross && !startsDown {
+				continue
+			}
+			num++
+			cellNumber := p.number(x, y, num)
+			for _, vertical := range []bool{false, true} {
+				if (!vertical && !startsAcross) || (vertical && !startsDown) {
+					continue
+				}
+				key := clueKey(cellNumber, vertical)
+				clue, ok := clues[key]
+				if !ok {
+					return nil, fmt.Errorf("no clue was found for %s", key)
+				}
+				delete(clues, key)
+				entry := Entry{Clue: clue, X: x, Y: y, Answer: p.answerAt(x, y, vertical)}
+				if err := entry.validate(); err != nil {
+					return nil, err
+				}
+				entries = append(entries, entry)
+			}
+		}
+	}
+	for key := range clues {
+		return nil, fmt.Errorf("clue %s does not match any answer in the grid", key)
+	}
+	return entries, nil
+}
+
+func (p *Puzzle) validateGrid() error {
+	if p.Width < 1 || p.Height < 1 {
+		return fmt.Errorf("invalid grid size %dx%d", p.Width, p.Height)
+	}
+	if len(p.Solution) != p.Height {
+		return fmt.Errorf("expected %d solution rows got %d", p.Height, len(p.Solution))
+	}
+	for y, row := range p.Solution {
+		if len(row) != p.Width {
+			return fmt.Errorf("expected %d solution columns in row %d got %d", p.Width, y+1, len(row))
+		}
+	}
+	return nil
+}
+
+func (p *Puzzle) answerAt(x, y int, vertical bool) string {
+	sb := &strings.Builder{}
+	for !p.isBlock(x, y) {
+		sb.WriteRune(unicode.ToUpper(p.Solution[y][x]))
+		if vertical {
+			y++
+		} else {
+			x++
+		}
+	}
+	return sb.String()
+}
+
+func (e Entry) validate() error {
+	for _, r := range e.Answer {
+		if r < 'A' || r > 'Z' {
+			return fmt.Errorf("answer for %s contains unsupported character %q: %s", clueKey(e.Number, e.Vertical), r, e.Answer)
+		}
+	}
+	if len(e.LettersCounts) > 0 {
+		total := 0
+		for _, v := range e.LettersCounts {
+			total += v
+		}
+		if total != len(e.Answer) {
+			return fmt.Errorf("answer for %s does not fit the clue's letter count: %s", clueKey(e.Number, e.Vertical), e.Answer)
+		}
+	}
+	return nil
+}
+
+// ToCrossword converts the puzzle to a crossword that can be rendered and played like a generated one.
+// The grid is padded to be square since the renderer expects it to be.
+func (p *Puzzle) ToCrossword() (*crossword.Crossword, error) {
+	entries, err := p.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	grid := crossword.NewGrid(max(p.Width, p.Height))
+	cw := &crossword.Crossword{Grid: grid}
+	for _, e := range entries {
+		for i, r := range e.Answer {
+			if e.Vertical {
+				grid[e.Y+i][e.X] = crossword.Cell{Char: r, CharIdx: i}
+			} else {
+				grid[e.Y][e.X+i] = crossword.Cell{Char: r, CharIdx: i}
+			}
+		}
+		cw.Words = append(cw.Words, crossword.Placement{
+			ID: e.Number,
+			Word: crossword.Word{
+				Word:          e.Answer,
+				Clue:          e.Text,
+				Label:         util.ToPtr(fmt.Sprintf("%d", e.Number)),
+				LettersCounts: e.LettersCounts,
+			},
+			X:        e.X,
+			Y:        e.Y,
+			Vertical: e.Vertical,
+		})
+	}
+	return cw, nil
+}
+
+// Load reads a puzzle from the given file. The format is based on the extension (.puz or .ipuz).
+func Load(filePath string) (*Puzzle, error) {
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".puz":
+		return LoadPuz(filePath)
+	case ".ipuz", ".json":
+		return LoadIPuz(filePath)
+	default:
+		return nil, fmt.Errorf("unknown puzzle format: %s", filePath)
+	}
+}
+
+func clueKey(number int, vertical bool) string {
+	if vertical {
+		return fmt.Sprintf("D%d", number)
+	}
+	return fmt.Sprintf("A%d", number)
+}
@@ -0,0 +1,199 @@
+package puzzle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// CAT
+// O#O
+// WET
+func examplePuz(scrambled bool) []byte {
+	header := make([]byte, puzHeaderLength)
+	copy(header[puzMagicOffset:], puzMagic)
+	header[puzWidthOffset] = 3
+	header[puzHeightOffset] = 3
+	binary.LittleEndian.PutUint16(header[puzNumCluesOffset:], 4)
+	if scrambled {
+		binary.LittleEndian.PutUint16(header[puzScrambledTag:], 4)
+	}
+	buff := bytes.NewBuffer(header)
+	buff.WriteString("CATO.OWET")
+	buff.WriteString("---.-----")
+	for _, str := range []string{"Title", "Author", "Copyright", "Feline", "Bovine", "Small child (3)", "Not dry", ""} {
+		buff.WriteString(str + "\x00")
+	}
+	return buff.Bytes()
+}
+
+const exampleIPuz = `ipuz({
+	"kind": ["http://ipuz.org/crossword#1"],
+	"title": "Title",
+	"dimensions": {"width": 3, "height": 3},
+	"puzzle": [[1, 0, 2], [0, "#", 0], [3, 0, 0]],
+	"solution": [["C", "A", "T"], ["O", "#", "O"], [{"value": "W"}, "E", "T"]],
+	"clues": {
+		"Across": [[1, "Feline"], {"number": 3, "clue": "Not dry"}],
+		"Down:Down": [[1, "Bovine"], "2 Small child"]
+	}
+})`
+
+func TestReadPuz(t *testing.T) {
+	p, err := ReadPuz(bytes.NewReader(examplePuz(false)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExampleCrossword(t, p)
+}
+
+func TestReadPuz_Scrambled(t *testing.T) {
+	if _, err := ReadPuz(bytes.NewReader(examplePuz(true))); err == nil {
+		t.Fatal("expected error for scrambled puzzle")
+	}
+}
+
+func TestReadIPuz(t *testing.T) {
+	p, err := ReadIPuz(strings.NewReader(exampleIPuz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExampleCrossword(t, p)
+}
+
+func TestPuzzle_EntriesMismatchedClues(t *testing.T) {
+	p, err := ReadIPuz(strings.NewReader(exampleIPuz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Clues = append(p.Clues, Clue{Number: 4, Text: "Does not fit"})
+	if _, err := p.Entries(); err == nil {
+		t.Fatal("expected error for clue with no answer")
+	}
+	p.Clues = p.Clues[1:]
+	if _, err := p.Entries(); err == nil {
+		t.Fatal("expected error for answer with no clue")
+	}
+}
+
+func assertExampleCrossword(t *testing.T, p *Puzzle) {
+	t.Helper()
+	if p.Title != "Title" {
+		t.Errorf("unexpected title: %s", p.Title)
+	}
+	cw, err := p.ToCrossword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"A1": "CAT", "D1": "COW", "D2": "TOT", "A3": "WET"}
+	if len(cw.Words) != len(expected) {
+		t.Fatalf("expected %d words got %d", len(expected), len(cw.Words))
+	}
+	for _, w := range cw.Words {
+		if expected[w.ClueID()] != w.Word.Word {
+			t.Errorf("expected %s to be %s got %s", w.ClueID(), expected[w.ClueID()], w.Word.Word)
+		}
+		if w.Word.Clue == "" {
+			t.Errorf("expected %s to have a clue", w.ClueID())
+		}
+	}
+	if cw.Grid[1][1].Char != 0 || cw.Grid[2][0].Char != 'W' {
+		t.Error("grid layout was not preserved")
+	}
+}
+
+func TestWriteIPuz_RoundTrip(t *testing.T) {
+	p, err := ReadIPuz(strings.NewReader(exampleIPuz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw, err := p.ToCrossword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buff := &bytes.Buffer{}
+	if err := WriteIPuz(buff, cw, ExportOptions{Title: "Title", IncludeSolution: true}); err != nil {
+		t.Fatal(err)
+	}
+	exported, err := ReadIPuz(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExampleCrossword(t, exported)
+}
+
+func TestWriteIPuz_NumbersStartCells(t *testing.T) {
+	p, err := ReadIPuz(strings.NewReader(exampleIPuz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw, err := p.ToCrossword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// generated crosswords give every word its own ID, even words that start in the same cell.
+	for k := range cw.Words {
+		cw.Words[k].ID = k + 10
+		cw.Words[k].Word.Label = nil
+	}
+
+	buff := &bytes.Buffer{}
+	if err := WriteIPuz(buff, cw, ExportOptions{Title: "Title", IncludeSolution: true}); err != nil {
+		t.Fatal(err)
+	}
+	exported, err := ReadIPuz(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExampleCrossword(t, exported)
+}
+
+func TestWriteSVG_HidesUnsolvedLetters(t *testing.T) {
+	p, err := ReadIPuz(strings.NewReader(exampleIPuz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw, err := p.ToCrossword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw.Words[0].Solved = true
+
+	buff := &bytes.Buffer{}
+	if err := WriteSVG(buff, cw, ExportOptions{Title: "Title", ShowSolved: true}); err != nil {
+		t.Fatal(err)
+	}
+	svg := buff.String()
+	if !strings.Contains(svg, ">C</text>") || strings.Contains(svg, ">W</text>") {
+		t.Error("expected only the solved letters to be shown")
+	}
+	if !strings.Contains(svg, "Feline (3)") {
+		t.Error("expected clues to be listed")
+	}
+}
+
+func TestWriteSVG_OrdersClues(t *testing.T) {
+	p, err := ReadIPuz(strings.NewReader(exampleIPuz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw, err := p.ToCrossword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Reverse(cw.Words)
+
+	buff := &bytes.Buffer{}
+	if err := WriteSVG(buff, cw, ExportOptions{Title: "Title"}); err != nil {
+		t.Fatal(err)
+	}
+	svg := buff.String()
+	if strings.Index(svg, "Feline (3)") > strings.Index(svg, "Not dry (3)") {
+		t.Error("expected across clues to be ordered by number")
+	}
+	if strings.Index(svg, "Bovine (3)") > strings.Index(svg, "Small child (3)") {
+		t.Error("expected down clues to be ordered by number")
+	}
+}
@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+var unsafeChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+func NewDir(dir string) *Dir {
+	return &Dir{dir: dir}
+}
+
+// Dir is a first-in-first-out queue of JSON documents, each stored as a file in a directory.
+type Dir struct {
+	dir string
+}
+
+// Push adds the value to the back of the queue. The name is only used to make the file easier to identify.
+func (d *Dir) Push(name string, v any) (string, error) {
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return "", err
+	}
+	fileName := fmt.Sprintf("%020d", time.Now().UnixNano())
+	if name = unsafeChars.ReplaceAllString(name, "-"); name != "" {
+		fileName += "-" + strings.Trim(name, "-")
+	}
+	fileName += ".json"
+
+	f, err := os.OpenFile(path.Join(d.dir, fileName), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return fileName, enc.Encode(v)
+}
+
+// List returns the file names of the queued items in order.
+func (d *Dir) List() ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	names := []string{}
+	for _, v := range entries {
+		if v.IsDir() || path.Ext(v.Name()) != ".json" {
+			continue
+		}
+		names = append(names, v.Name())
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// Len returns the number of queued items.
+func (d *Dir) Len() (int, error) {
+	names, err := d.List()
+	return len(names), err
+}
+
+// Get decodes the named item into v.
+func (d *Dir) Get(name string, v any) error {
+	f, err := os.Open(path.Join(d.dir, path.Base(name)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewDecoder(f).Decode(v)
+}
+
+// Remove deletes the named item from the queue.
+func (d *Dir) Remove(name string) error {
+	return os.Remove(path.Join(d.dir, path.Base(name)))
+}
+
+// Peek decodes the item at the front of the queue into v without removing it and returns its name. False is
+// returned if the queue is empty.
+func (d *Dir) Peek(v any) (string, bool, error) {
+	names, err := d.List()
+	if err != nil || len(names) == 0 {
+		return "", false, err
+	}
+	if err := d.Get(names[0], v); err != nil {
+		return "", false, fmt.Errorf("failed to decode %s: %w", names[0], err)
+	}
+	return names[0], true, nil
+}
+
+// Pop decodes the item at the front of the queue into v and removes it. False is returned if the queue is empty.
+func (d *Dir) Pop(v any) (bool, error) {
+	name, ok, err := d.Peek(v)
+	if err != nil || !ok {
+		return false, err
+	}
+	return true, d.Remove(name)
+}
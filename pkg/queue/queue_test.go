@@ -0,0 +1,64 @@
+package queue
+
+import (
+	"testing"
+)
+
+func TestDir_PushPop(t *testing.T) {
+	q := NewDir(t.TempDir())
+
+	for _, v := range []string{"first", "second", "third"} {
+		if _, err := q.Push(v, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := q.Len(); err != nil || n != 3 {
+		t.Fatalf("expected 3 items got %d (%v)", n, err)
+	}
+	for _, expected := range []string{"first", "second", "third"} {
+		var got string
+		ok, err := q.Pop(&got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok || got != expected {
+			t.Fatalf("expected %s got %s", expected, got)
+		}
+	}
+	var got string
+	if ok, err := q.Pop(&got); ok || err != nil {
+		t.Fatalf("expected empty queue got %s (%v)", got, err)
+	}
+}
+
+func TestDir_ListMissingDir(t *testing.T) {
+	q := NewDir(t.TempDir() + "/missing")
+	names, err := q.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 0 {
+		t.Fatalf("expected no items got %d", len(names))
+	}
+}
+
+func TestDir_Peek(t *testing.T) {
+	q := NewDir(t.TempDir())
+	if _, err := q.Push("first", "first"); err != nil {
+		t.Fatal(err)
+	}
+	var got string
+	name, ok, err := q.Peek(&got)
+	if err != nil || !ok || got != "first" {
+		t.Fatalf("expected first got %s (%v)", got, err)
+	}
+	if n, err := q.Len(); err != nil || n != 1 {
+		t.Fatalf("expected peek to leave the item queued got %d (%v)", n, err)
+	}
+	if err := q.Remove(name); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := q.Peek(&got); ok || err != nil {
+		t.Fatalf("expected empty queue (%v)", err)
+	}
+}
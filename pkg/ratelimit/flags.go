@@ -0,0 +1,26 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/warmans/gamesmaster/pkg/flag"
+)
+
+// OverrideFlags are the flags used by commands that create a game to replace the bot's guess limits.
+type OverrideFlags struct {
+	maxAttempts int64
+	window      time.Duration
+	cooldown    time.Duration
+}
+
+func (f *OverrideFlags) Register(flagSet *pflag.FlagSet) {
+	flag.Int64VarEnv(flagSet, &f.maxAttempts, "", "game-guess-limit", -1, "max guesses a user can make at a single item in this game (-1 to use the bot's guess-limit, 0 to disable)")
+	flag.DurationVarEnv(flagSet, &f.window, "", "game-guess-limit-window", 0, "window in which guesses are counted towards the game-guess-limit (0 to use the bot's guess-limit-window)")
+	flag.DurationVarEnv(flagSet, &f.cooldown, "", "game-guess-cooldown", -time.Second, "how long a user must wait after exceeding the game-guess-limit (negative to use the bot's guess-cooldown)")
+}
+
+// Override returns nil if none of the flags were set.
+func (f *OverrideFlags) Override() *Override {
+	return NewOverride(f.maxAttempts, f.window, f.cooldown)
+}
@@ -0,0 +1,226 @@
+package ratelimit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	// MaxAttempts is the number of attempts allowed per user and item within the Window. Zero disables the limit.
+	MaxAttempts int64
+	// Window must be positive if the limit is enabled.
+	Window time.Duration
+	// Cooldown is how long a user must wait after exceeding the limit.
+	Cooldown time.Duration
+}
+
+// Validate checks the window is set if the limit is enabled. Without a window no attempts would be counted.
+func (c Config) Validate() error {
+	if c.MaxAttempts > 0 && c.Window <= 0 {
+		return fmt.Errorf("a positive window is required when the max attempts is %d", c.MaxAttempts)
+	}
+	return nil
+}
+
+// Override replaces the limits for a single game. Nil fields use the limiter's config, as does a window that is
+// not positive.
+type Override struct {
+	MaxAttempts *int64
+	Window      *time.Duration
+	Cooldown    *time.Duration
+}
+
+// NewOverride creates an override from flag values where a negative value means the limiter's config should be
+// used. It returns nil if none of the values were set.
+func NewOverride(maxAttempts int64, window time.Duration, cooldown time.Duration) *Override {
+	o := &Override{}
+	if maxAttempts >= 0 {
+		o.MaxAttempts = &maxAttempts
+	}
+	if window > 0 {
+		o.Window = &window
+	}
+	if cooldown >= 0 {
+		o.Cooldown = &cooldown
+	}
+	if o.MaxAttempts == nil && o.Window == nil && o.Cooldown == nil {
+		return nil
+	}
+	return o
+}
+
+// Apply returns the config with any fields set in the override replaced.
+func (c Config) Apply(o *Override) Config {
+	if o == nil {
+		return c
+	}
+	if o.MaxAttempts != nil {
+		c.MaxAttempts = *o.MaxAttempts
+	}
+	if o.Window != nil && *o.Window > 0 {
+		c.Window = *o.Window
+	}
+	if o.Cooldown != nil {
+		c.Cooldown = *o.Cooldown
+	}
+	return c
+}
+
+type Cooldown struct {
+	Game  string
+	User  string
+	Item  string
+	Until time.Time
+}
+
+func NewLimiter(cfg Config) *Limiter {
+	return &Limiter{
+		cfg:       cfg,
+		attempts:  make(map[limiterKey]*attempts),
+		cooldowns: make(map[limiterKey]Cooldown),
+		now:       time.Now,
+	}
+}
+
+// Limiter tracks attempts per game, user and item e.g. guesses at a specific poster in one guild's game.
+type Limiter struct {
+	cfg       Config
+	lock      sync.Mutex
+	attempts  map[limiterKey]*attempts
+	cooldowns map[limiterKey]Cooldown
+	now       func() time.Time
+}
+
+// attempts are the times of recent attempts and the window they are counted in.
+type attempts struct {
+	times  []time.Time
+	window time.Duration
+}
+
+// forget removes the attempts that are outside the window and returns how many remain.
+func (a *attempts) forget(now time.Time) int {
+	a.times = slices.DeleteFunc(a.times, func(t time.Time) bool {
+		return now.Sub(t) >= a.window
+	})
+	return len(a.times)
+}
+
+// Allow records an attempt and returns false if the user has exceeded the limit for the item.
+// Attempts made during a cooldown are not recorded.
+func (l *Limiter) Allow(game string, user string, item string) bool {
+	return l.AllowOverride(game, user, item, nil)
+}
+
+// AllowOverride is the same as Allow but uses the limits in the override where they are set
+// e.g. for a game with its own limits.
+func (l *Limiter) AllowOverride(game string, user string, item string, override *Override) bool {
+	if l == nil {
+		return true
+	}
+	cfg := l.cfg.Apply(override)
+	if cfg.MaxAttempts <= 0 || cfg.Validate() != nil {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.forgetExpired(now)
+
+	key := newLimiterKey(game, user, item)
+	if _, ok := l.cooldowns[key]; ok {
+		return false
+	}
+
+	recent, ok := l.attempts[key]
+	if !ok {
+		recent = &attempts{}
+		l.attempts[key] = recent
+	}
+	recent.window = cfg.Window
+	if int64(recent.forget(now)) >= cfg.MaxAttempts {
+		until := recent.times[0].Add(cfg.Window)
+		if cfg.Cooldown > 0 {
+			until = now.Add(cfg.Cooldown)
+		}
+		l.cooldowns[key] = Cooldown{Game: game, User: user, Item: item, Until: until}
+		delete(l.attempts, key)
+		return false
+	}
+	recent.times = append(recent.times, now)
+	return true
+}
+
+// forgetExpired removes attempts outside their window and cooldowns that have ended so the limiter only holds
+// recent activity.
+func (l *Limiter) forgetExpired(now time.Time) {
+	for key, v := range l.attempts {
+		if v.forget(now) == 0 {
+			delete(l.attempts, key)
+		}
+	}
+	for key, cd := range l.cooldowns {
+		if !now.Before(cd.Until) {
+			delete(l.cooldowns, key)
+		}
+	}
+}
+
+// Cooldowns lists the users currently unable to make attempts.
+func (l *Limiter) Cooldowns() []Cooldown {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	out := []Cooldown{}
+	for key, cd := range l.cooldowns {
+		if !now.Before(cd.Until) {
+			delete(l.cooldowns, key)
+			continue
+		}
+		out = append(out, cd)
+	}
+	slices.SortFunc(out, func(a, b Cooldown) int {
+		return a.Until.Compare(b.Until)
+	})
+	return out
+}
+
+// Clear removes all cooldowns and recorded attempts for the user. It returns the number of cooldowns removed.
+func (l *Limiter) Clear(user string) int {
+	if l == nil {
+		return 0
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	cleared := 0
+	for key, cd := range l.cooldowns {
+		if strings.EqualFold(cd.User, user) {
+			delete(l.cooldowns, key)
+			cleared++
+		}
+	}
+	for key := range l.attempts {
+		if key.user == strings.ToLower(user) {
+			delete(l.attempts, key)
+		}
+	}
+	return cleared
+}
+
+type limiterKey struct {
+	game string
+	user string
+	item string
+}
+
+func newLimiterKey(game string, user string, item string) limiterKey {
+	return limiterKey{game: game, user: strings.ToLower(user), item: strings.ToLower(item)}
+}
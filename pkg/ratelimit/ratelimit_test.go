@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewLimiter(Config{MaxAttempts: 2, Window: time.Minute, Cooldown: time.Minute * 5})
+	l.now = func() time.Time { return now }
+
+	if !l.Allow("g", "bob", "1") || !l.Allow("g", "bob", "1") {
+		t.Fatal("expected first two attempts to be allowed")
+	}
+	if l.Allow("g", "bob", "1") {
+		t.Fatal("expected third attempt to be limited")
+	}
+	if !l.Allow("g", "bob", "2") {
+		t.Fatal("expected attempt at a different item to be allowed")
+	}
+	if !l.Allow("g", "alice", "1") {
+		t.Fatal("expected attempt by a different user to be allowed")
+	}
+	if len(l.Cooldowns()) != 1 {
+		t.Fatalf("expected 1 cooldown, got %d", len(l.Cooldowns()))
+	}
+
+	now = now.Add(time.Minute * 2)
+	if l.Allow("g", "bob", "1") {
+		t.Fatal("expected attempt during cooldown to be limited")
+	}
+
+	now = now.Add(time.Minute * 4)
+	if !l.Allow("g", "bob", "1") {
+		t.Fatal("expected attempt after cooldown to be allowed")
+	}
+}
+
+func TestLimiter_Clear(t *testing.T) {
+	l := NewLimiter(Config{MaxAttempts: 1, Window: time.Minute, Cooldown: time.Hour})
+	l.Allow("g", "bob", "1")
+	if l.Allow("g", "bob", "1") {
+		t.Fatal("expected second attempt to be limited")
+	}
+	if cleared := l.Clear("Bob"); cleared != 1 {
+		t.Fatalf("expected 1 cooldown to be cleared, got %d", cleared)
+	}
+	if !l.Allow("g", "bob", "1") {
+		t.Fatal("expected attempt after clear to be allowed")
+	}
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	l := NewLimiter(Config{})
+	for range 100 {
+		if !l.Allow("g", "bob", "1") {
+			t.Fatal("expected disabled limiter to allow all attempts")
+		}
+	}
+}
+
+func TestLimiter_AllowOverride(t *testing.T) {
+	l := NewLimiter(Config{MaxAttempts: 1, Window: time.Minute})
+
+	override := NewOverride(3, -1, -1)
+	for range 3 {
+		if !l.AllowOverride("g", "bob", "1", override) {
+			t.Fatal("expected attempts within the overridden limit to be allowed")
+		}
+	}
+	if l.AllowOverride("g", "bob", "1", override) {
+		t.Fatal("expected attempt over the overridden limit to be limited")
+	}
+
+	disabled := NewOverride(0, -1, -1)
+	if !l.AllowOverride("g", "alice", "1", disabled) || !l.AllowOverride("g", "alice", "1", disabled) {
+		t.Fatal("expected override to disable the limit")
+	}
+	if NewOverride(-1, 0, -1) != nil {
+		t.Fatal("expected no override when no values were set")
+	}
+}
+
+func TestLimiter_SeparateGames(t *testing.T) {
+	l := NewLimiter(Config{MaxAttempts: 1, Window: time.Minute})
+	if !l.Allow("guild1", "bob", "3") || l.Allow("guild1", "bob", "3") {
+		t.Fatal("expected second attempt in the same game to be limited")
+	}
+	if !l.Allow("guild2", "bob", "3") {
+		t.Fatal("expected attempt at the same item in a different game to be allowed")
+	}
+}
+
+func TestLimiter_ForgetsExpiredAttempts(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewLimiter(Config{MaxAttempts: 2, Window: time.Minute})
+	l.now = func() time.Time { return now }
+
+	l.Allow("g", "bob", "1")
+	if len(l.attempts) != 1 {
+		t.Fatalf("expected 1 tracked key, got %d", len(l.attempts))
+	}
+	now = now.Add(time.Minute * 2)
+	l.Allow("g", "alice", "1")
+	if _, ok := l.attempts[newLimiterKey("g", "bob", "1")]; ok || len(l.attempts) != 1 {
+		t.Fatal("expected expired attempts to be forgotten")
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	if err := (Config{MaxAttempts: 1}).Validate(); err == nil {
+		t.Fatal("expected a limit without a window to be invalid")
+	}
+	if err := (Config{}).Validate(); err != nil {
+		t.Fatalf("expected a disabled limit to be valid: %s", err)
+	}
+	zero := time.Duration(0)
+	if cfg := (Config{MaxAttempts: 1, Window: time.Minute}).Apply(&Override{Window: &zero}); cfg.Window != time.Minute {
+		t.Fatalf("expected a zero window override to be ignored, got %s", cfg.Window)
+	}
+}
@@ -0,0 +1,97 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"sync"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/draw"
+)
+
+// Tile is one image on a board. Tiles are only redrawn when their key changes so the key must include
+// everything that affects how the tile looks e.g. the image path and whether it was guessed.
+type Tile struct {
+	Key       string
+	ImagePath string
+	X         int
+	Y         int
+	Width     int
+	Height    int
+}
+
+// Board keeps the last rendered board so only the tiles that changed are redrawn.
+type Board struct {
+	mu     sync.Mutex
+	canvas *image.RGBA
+	keys   []string
+}
+
+// Render draws any changed tiles onto the board and returns a copy of it. drawTile is called for each changed
+// tile with the tile's image after the tile area has been cleared, and should draw the image along with any
+// labels. Images for the changed tiles are loaded in parallel.
+func (b *Board) Render(cache *Cache, width int, height int, tiles []Tile, drawTile func(dc *gg.Context, k int, img image.Image)) (*gg.Context, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.canvas == nil || b.canvas.Rect.Dx() != width || b.canvas.Rect.Dy() != height || len(b.keys) != len(tiles) {
+		b.canvas = image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(b.canvas, b.canvas.Rect, image.NewUniform(color.Black), image.Point{}, draw.Src)
+		b.keys = make([]string, len(tiles))
+	}
+
+	var changed []int
+	var paths []string
+	for k, v := range tiles {
+		if b.keys[k] != v.Key || v.Key == "" {
+			changed = append(changed, k)
+			paths = append(paths, v.ImagePath)
+		}
+	}
+	if len(changed) > 0 {
+		// all tiles on a board are the same size
+		images, err := cache.GetAll(paths, tiles[changed[0]].Width, tiles[changed[0]].Height)
+		if err != nil {
+			// the board may be partly drawn so force a full redraw next time
+			b.canvas = nil
+			return nil, err
+		}
+		dc := gg.NewContextForRGBA(b.canvas)
+		for i, k := range changed {
+			tile := tiles[k]
+			draw.Draw(b.canvas, image.Rect(tile.X, tile.Y, tile.X+tile.Width, tile.Y+tile.Height), image.NewUniform(color.Black), image.Point{}, draw.Src)
+			drawTile(dc, k, images[i])
+			b.keys[k] = tile.Key
+		}
+	}
+
+	out := image.NewRGBA(b.canvas.Rect)
+	copy(out.Pix, b.canvas.Pix)
+	return gg.NewContextForRGBA(out), nil
+}
+
+// Boards holds a board for each game.
+type Boards struct {
+	mu     sync.Mutex
+	boards map[string]*Board
+}
+
+// Get returns the board with the given name, creating it if needed.
+func (b *Boards) Get(name string) *Board {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.boards == nil {
+		b.boards = map[string]*Board{}
+	}
+	if _, ok := b.boards[name]; !ok {
+		b.boards[name] = &Board{}
+	}
+	return b.boards[name]
+}
+
+// EncodePNG encodes the board favouring speed over size since boards are re-uploaded after every guess.
+func EncodePNG(w io.Writer, dc *gg.Context) error {
+	return (&png.Encoder{CompressionLevel: png.BestSpeed}).Encode(w, dc.Image())
+}
@@ -0,0 +1,120 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"golang.org/x/image/draw"
+)
+
+// DefaultCache is shared by all the game boards.
+var DefaultCache = NewCache(2000)
+
+type cacheKey struct {
+	path   string
+	width  int
+	height int
+}
+
+type cacheEntry struct {
+	modTime time.Time
+	size    int64
+	img     *image.RGBA
+}
+
+// Cache holds decoded images resized to the size they are drawn at. Images are reloaded if the file
+// changes e.g. when obscured images are regenerated.
+type Cache struct {
+	mu         sync.Mutex
+	entries    map[cacheKey]cacheEntry
+	maxEntries int
+}
+
+func NewCache(maxEntries int) *Cache {
+	return &Cache{entries: map[cacheKey]cacheEntry{}, maxEntries: maxEntries}
+}
+
+// Get returns the image resized to width x height. A zero width or height keeps the original size.
+func (c *Cache) Get(imagePath string, width int, height int) (*image.RGBA, error) {
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		return nil, err
+	}
+	key := cacheKey{path: imagePath, width: width, height: height}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && entry.modTime.Equal(info.ModTime()) && entry.size == info.Size() {
+		return entry.img, nil
+	}
+
+	img, err := load(imagePath, width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// entries are never evicted individually, the cache is just reset if it gets too big
+	if len(c.entries) >= c.maxEntries {
+		clear(c.entries)
+	}
+	c.entries[key] = cacheEntry{modTime: info.ModTime(), size: info.Size(), img: img}
+	return img, nil
+}
+
+// GetAll loads the images in parallel. The images are returned in the same order as the paths.
+func (c *Cache) GetAll(paths []string, width int, height int) ([]*image.RGBA, error) {
+	images := make([]*image.RGBA, len(paths))
+	errs := make([]error, len(paths))
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for range min(len(paths), runtime.NumCPU()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range jobs {
+				images[k], errs[k] = c.Get(paths[k], width, height)
+			}
+		}()
+	}
+	for k := range paths {
+		jobs <- k
+	}
+	close(jobs)
+	wg.Wait()
+
+	return images, errors.Join(errs...)
+}
+
+func load(imagePath string, width int, height int) (*image.RGBA, error) {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", imagePath, err)
+	}
+	bounds := img.Bounds()
+	if width <= 0 || height <= 0 {
+		width, height = bounds.Dx(), bounds.Dy()
+	}
+	out := image.NewRGBA(image.Rect(0, 0, width, height))
+	if bounds.Dx() == width && bounds.Dy() == height {
+		draw.Draw(out, out.Bounds(), img, bounds.Min, draw.Src)
+	} else {
+		draw.CatmullRom.Scale(out, out.Bounds(), img, bounds, draw.Src, nil)
+	}
+	return out, nil
+}
@@ -0,0 +1,109 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image/jpeg"
+
+	"github.com/fogleman/gg"
+)
+
+const (
+	DefaultMaxWidth  = 2000
+	DefaultMaxHeight = 3000
+	// DefaultMaxFileSize is below Discord's attachment limit for servers without boosts.
+	DefaultMaxFileSize = 8 * 1024 * 1024
+)
+
+// Page is one image of a board that is too big to show in a single image.
+type Page struct {
+	// Number starts at 1.
+	Number int
+	// First and Last are the numbers of the first and last items on the page as shown on the board.
+	First int
+	Last  int
+	// Key changes whenever the page would look different.
+	Key    string
+	Canvas *gg.Context
+}
+
+// Layout splits a board of equally sized tiles into pages that fit in the maximum dimensions. Items are
+// numbered continuously across pages.
+type Layout struct {
+	TileWidth  int
+	TileHeight int
+	// Columns is the preferred number of tiles per row. It is reduced if the row would be wider than MaxWidth.
+	Columns   int
+	MaxWidth  int
+	MaxHeight int
+}
+
+// PageColumns returns the number of tiles in each row of a page.
+func (l Layout) PageColumns() int {
+	columns := max(1, l.Columns)
+	if l.MaxWidth > 0 && l.TileWidth > 0 {
+		columns = min(columns, l.MaxWidth/l.TileWidth)
+	}
+	return max(1, columns)
+}
+
+// PageRows returns the maximum number of rows on each page.
+func (l Layout) PageRows() int {
+	if l.MaxHeight <= 0 || l.TileHeight <= 0 {
+		return 1
+	}
+	return max(1, l.MaxHeight/l.TileHeight)
+}
+
+// PageSize is the maximum number of items on each page.
+func (l Layout) PageSize() int {
+	return l.PageColumns() * l.PageRows()
+}
+
+// NumPages returns the number of pages needed for the items. There is always at least one page.
+func (l Layout) NumPages(numItems int) int {
+	return max(1, (numItems+l.PageSize()-1)/l.PageSize())
+}
+
+// PageItems returns the range of item indexes [first, last) on the page (starting from 0).
+func (l Layout) PageItems(page int, numItems int) (int, int) {
+	return min(numItems, page*l.PageSize()), min(numItems, (page+1)*l.PageSize())
+}
+
+// PageWidth is the width of a page in pixels.
+func (l Layout) PageWidth() int {
+	return l.PageColumns() * l.TileWidth
+}
+
+// PageHeight is the height of a page with the given number of items in pixels.
+func (l Layout) PageHeight(numItems int) int {
+	return max(1, (numItems+l.PageColumns()-1)/l.PageColumns()) * l.TileHeight
+}
+
+// Position returns the top left corner of the tile of the item with the given index within its page.
+func (l Layout) Position(idx int) (int, int) {
+	idx = idx % l.PageSize()
+	return (idx % l.PageColumns()) * l.TileWidth, (idx / l.PageColumns()) * l.TileHeight
+}
+
+// Encode encodes the page as PNG if it fits in maxFileSize, otherwise as progressively lower quality JPEGs.
+// The file extension to use is also returned. A zero maxFileSize means no limit.
+func Encode(dc *gg.Context, maxFileSize int) (*bytes.Buffer, string, error) {
+	buff := &bytes.Buffer{}
+	if err := EncodePNG(buff, dc); err != nil {
+		return nil, "", err
+	}
+	if maxFileSize <= 0 || buff.Len() <= maxFileSize {
+		return buff, "png", nil
+	}
+	for _, quality := range []int{90, 75, 60, 45, 30} {
+		buff.Reset()
+		if err := jpeg.Encode(buff, dc.Image(), &jpeg.Options{Quality: quality}); err != nil {
+			return nil, "", err
+		}
+		if buff.Len() <= maxFileSize {
+			return buff, "jpg", nil
+		}
+	}
+	return nil, "", fmt.Errorf("board is too large to encode in %d bytes, reduce the maximum board size", maxFileSize)
+}
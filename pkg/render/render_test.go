@@ -0,0 +1,145 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/fogleman/gg"
+)
+
+func writePNG(t *testing.T, imagePath string, c color.Color) {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 40, 60))
+	for y := range 60 {
+		for x := range 40 {
+			img.Set(x, y, c)
+		}
+	}
+	f, err := os.Create(imagePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCache_Get(t *testing.T) {
+	imagePath := path.Join(t.TempDir(), "a.png")
+	writePNG(t, imagePath, color.White)
+
+	cache := NewCache(10)
+	img, err := cache.Get(imagePath, 20, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds().Dx() != 20 || img.Bounds().Dy() != 30 {
+		t.Fatalf("expected image to be resized got %v", img.Bounds())
+	}
+	if again, _ := cache.Get(imagePath, 20, 30); again != img {
+		t.Fatal("expected cached image")
+	}
+
+	// changed files are reloaded
+	writePNG(t, imagePath, color.Black)
+	if err := os.Chtimes(imagePath, time.Now(), time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := cache.Get(imagePath, 20, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded == img || reloaded.RGBAAt(0, 0).R != 0 {
+		t.Fatal("expected image to be reloaded")
+	}
+}
+
+func TestBoard_Render(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"a.png", "b.png", "c.png"} {
+		paths = append(paths, path.Join(dir, name))
+		writePNG(t, paths[len(paths)-1], color.White)
+	}
+	tiles := func(keys ...string) []Tile {
+		out := make([]Tile, len(keys))
+		for k, v := range keys {
+			out[k] = Tile{Key: v, ImagePath: paths[k], X: k * 20, Width: 20, Height: 30}
+		}
+		return out
+	}
+
+	board := &Board{}
+	var drawn []int
+	draw := func(dc *gg.Context, k int, img image.Image) {
+		drawn = append(drawn, k)
+		dc.DrawImage(img, k*20, 0)
+	}
+	if _, err := board.Render(NewCache(10), 60, 30, tiles("a", "b", "c"), draw); err != nil {
+		t.Fatal(err)
+	}
+	if len(drawn) != 3 {
+		t.Fatalf("expected all tiles to be drawn got %v", drawn)
+	}
+
+	drawn = nil
+	dc, err := board.Render(NewCache(10), 60, 30, tiles("a", "b2", "c"), draw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(drawn) != 1 || drawn[0] != 1 {
+		t.Fatalf("expected only the changed tile to be drawn got %v", drawn)
+	}
+	if r, _, _, _ := dc.Image().At(45, 10).RGBA(); r == 0 {
+		t.Fatal("expected unchanged tiles to be kept")
+	}
+}
+
+func TestLayout(t *testing.T) {
+	layout := Layout{TileWidth: 200, TileHeight: 300, Columns: 8, MaxWidth: 1000, MaxHeight: 1000}
+	if layout.PageColumns() != 5 {
+		t.Fatalf("expected columns to be limited by the width got %d", layout.PageColumns())
+	}
+	if layout.PageSize() != 15 {
+		t.Fatalf("expected 15 items per page got %d", layout.PageSize())
+	}
+	if layout.NumPages(31) != 3 || layout.NumPages(0) != 1 {
+		t.Fatalf("unexpected number of pages: %d, %d", layout.NumPages(31), layout.NumPages(0))
+	}
+	if first, last := layout.PageItems(2, 31); first != 30 || last != 31 {
+		t.Fatalf("unexpected items on last page: %d-%d", first, last)
+	}
+	if x, y := layout.Position(21); x != 200 || y != 300 {
+		t.Fatalf("unexpected position: %d,%d", x, y)
+	}
+	if layout.PageWidth() != 1000 || layout.PageHeight(1) != 300 || layout.PageHeight(15) != 900 {
+		t.Fatalf("unexpected page size: %dx%d", layout.PageWidth(), layout.PageHeight(15))
+	}
+}
+
+func TestEncode(t *testing.T) {
+	dc := gg.NewContext(200, 200)
+	for y := range 200 {
+		for x := range 200 {
+			dc.SetRGB255((x*7)%256, (y*13)%256, (x*y)%256)
+			dc.SetPixel(x, y)
+		}
+	}
+	buff, ext, err := Encode(dc, 0)
+	if err != nil || ext != "png" {
+		t.Fatalf("expected png got %s: %v", ext, err)
+	}
+	buff, ext, err = Encode(dc, buff.Len()-1)
+	if err != nil || ext != "jpg" {
+		t.Fatalf("expected jpg fallback got %s: %v", ext, err)
+	}
+	if _, _, err := Encode(dc, 10); err == nil {
+		t.Fatal("expected error for impossible size")
+	}
+}
@@ -9,6 +9,11 @@ import (
 type Score struct {
 	Points  int
 	Answers int
+	// Parts is the number of partial answers given e.g. the artist of an "artist - title" answer.
+	Parts int
+	// Hints is the number of hints requested and HintPenalty the total points deducted for them.
+	Hints       int
+	HintPenalty int
 }
 
 func NewTiered(totalAnswers int) *Tiered {
@@ -50,6 +55,27 @@ func (t *Tiered) Add(userName string) {
 	t.LastUser = userName
 }
 
+// AddPartial awards a single point for solving part of an answer. It does not count as an answer
+// so the tiers are unaffected.
+func (t *Tiered) AddPartial(userName string) {
+	if _, exists := t.Scores[userName]; !exists {
+		t.Scores[userName] = &Score{}
+	}
+	t.Scores[userName].Points++
+	t.Scores[userName].Parts++
+	t.LastUser = userName
+}
+
+// AddHint deducts the penalty for requesting a hint. It does not affect who answered last.
+func (t *Tiered) AddHint(userName string, penalty int) {
+	if _, exists := t.Scores[userName]; !exists {
+		t.Scores[userName] = &Score{}
+	}
+	t.Scores[userName].Points -= penalty
+	t.Scores[userName].Hints++
+	t.Scores[userName].HintPenalty += penalty
+}
+
 func (t *Tiered) Render() string {
 	var scoreSlice []struct {
 		score    *Score
@@ -74,7 +100,14 @@ func (t *Tiered) Render() string {
 
 	sb := &strings.Builder{}
 	for k, v := range scoreSlice {
-		fmt.Fprintf(sb, "%d. %s: %d (%d answered)\n", k+1, v.userName, v.score.Points, v.score.Answers)
+		breakdown := []string{fmt.Sprintf("%d answered", v.score.Answers)}
+		if v.score.Parts > 0 {
+			breakdown = append(breakdown, fmt.Sprintf("%d partial", v.score.Parts))
+		}
+		if v.score.Hints > 0 {
+			breakdown = append(breakdown, fmt.Sprintf("%d hints -%d", v.score.Hints, v.score.HintPenalty))
+		}
+		fmt.Fprintf(sb, "%d. %s: %d (%s)\n", k+1, v.userName, v.score.Points, strings.Join(breakdown, ", "))
 	}
 	return sb.String()
 }
@@ -9,22 +9,218 @@ import (
 
 var whitespace = regexp.MustCompile(`\s+`)
 
+type Metric string
+
+const (
+	MetricLevenshtein Metric = "levenshtein"
+	MetricJaroWinkler Metric = "jaro-winkler"
+	MetricTokenSet    Metric = "token-set"
+	MetricHamming     Metric = "hamming"
+)
+
+const DefaultMatchThreshold = 0.8
+
+// tokenMatchThreshold is the similarity at which two individual words are considered the same.
+const tokenMatchThreshold = 0.6
+
+// closeMargin is how far below the threshold a guess can be while still being considered close.
+const closeMargin = 0.15
+
+// GuessResult classifies how near a guess was to the answer.
+type GuessResult int
+
+const (
+	GuessWrong GuessResult = iota
+	// GuessPartial means at least one of the words in the guess is in the answer.
+	GuessPartial
+	// GuessClose means the guess was similar to the answer, but not similar enough.
+	GuessClose
+	GuessCorrect
+)
+
+// fillerWords carry no weight when comparing token sets.
+var fillerWords = []string{"a", "an", "the", "and", "of", "&"}
+
+// Matcher compares guesses to answers after normalising both. A nil Matcher uses the defaults.
+type Matcher struct {
+	Metric    Metric
+	Threshold float64
+}
+
+func DefaultMatcher() *Matcher {
+	return &Matcher{Metric: MetricLevenshtein, Threshold: DefaultMatchThreshold}
+}
+
+func (m *Matcher) resolve() *Matcher {
+	resolved := DefaultMatcher()
+	if m == nil {
+		return resolved
+	}
+	if m.Metric != "" {
+		resolved.Metric = m.Metric
+	}
+	if m.Threshold > 0 {
+		resolved.Threshold = m.Threshold
+	}
+	return resolved
+}
+
+// Similarity returns a number between 0 and 1 indicating how close the guess is to the answer.
+func (m *Matcher) Similarity(guess string, answer string) float64 {
+	guess, answer = Normalise(guess), Normalise(answer)
+	if guess == "" || answer == "" {
+		return 0
+	}
+	if guess == answer {
+		return 1
+	}
+	switch m.resolve().Metric {
+	case MetricJaroWinkler:
+		return strutil.Similarity(guess, answer, metrics.NewJaroWinkler())
+	case MetricTokenSet:
+		return tokenSetSimilarity(guess, answer)
+	case MetricHamming:
+		return strutil.Similarity(guess, answer, metrics.NewHamming())
+	default:
+		return strutil.Similarity(guess, answer, metrics.NewLevenshtein())
+	}
+}
+
+// Matches returns true if the guess is similar enough to the answer.
+func (m *Matcher) Matches(guess string, answer string) bool {
+	return m.Similarity(guess, answer) >= m.resolve().Threshold
+}
+
+// MatchesAny returns true if the guess matches any of the accepted answers, unless it also matches a rejected
+// answer at least as closely. An exact match of an accepted answer always wins.
+func (m *Matcher) MatchesAny(guess string, accepted []string, rejected []string) bool {
+	if m.exactlyMatchesAny(guess, accepted) {
+		return true
+	}
+	bestAccepted := m.bestSimilarity(guess, accepted)
+	if m.matchesRejected(guess, rejected, bestAccepted) {
+		return false
+	}
+	return bestAccepted >= m.resolve().Threshold
+}
+
+// exactlyMatchesAny returns true if the normalised guess is the same as any of the normalised answers.
+func (m *Matcher) exactlyMatchesAny(guess string, answers []string) bool {
+	normalisedGuess := Normalise(guess)
+	for _, a := range answers {
+		if normalisedGuess == Normalise(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// bestSimilarity returns the similarity of the answer closest to the guess.
+func (m *Matcher) bestSimilarity(guess string, answers []string) float64 {
+	best := 0.0
+	for _, a := range answers {
+		best = max(best, m.Similarity(guess, a))
+	}
+	return best
+}
+
+// matchesRejected returns true if the guess matches a rejected answer using the same rules as accepted answers,
+// so misspellings of a rejected answer are rejected too, and it is at least as close as the best accepted answer.
+func (m *Matcher) matchesRejected(guess string, rejected []string, bestAccepted float64) bool {
+	bestRejected := m.bestSimilarity(guess, rejected)
+	return bestRejected >= m.resolve().Threshold && bestRejected >= bestAccepted
+}
+
+// Classify places the guess into a band based on how close it was to the accepted answers.
+// Rejected answers are always near-misses so are considered close.
+func (m *Matcher) Classify(guess string, accepted []string, rejected []string) GuessResult {
+	if m.exactlyMatchesAny(guess, accepted) {
+		return GuessCorrect
+	}
+	bestSimilarity := m.bestSimilarity(guess, accepted)
+	if m.matchesRejected(guess, rejected, bestSimilarity) {
+		return GuessClose
+	}
+	normalisedGuess := Normalise(guess)
+	if bestSimilarity >= m.resolve().Threshold {
+		return GuessCorrect
+	}
+	if bestSimilarity >= m.resolve().Threshold-closeMargin {
+		return GuessClose
+	}
+	lev := metrics.NewLevenshtein()
+	for _, g := range strings.Fields(normalisedGuess) {
+		if InStrings(g, fillerWords...) {
+			continue
+		}
+		for _, a := range accepted {
+			for _, w := range strings.Fields(Normalise(a)) {
+				if strutil.Similarity(g, w, lev) >= DefaultMatchThreshold {
+					return GuessPartial
+				}
+			}
+		}
+	}
+	return GuessWrong
+}
+
 func WithoutSpaces(guess string) string {
 	return whitespace.ReplaceAllString(guess, "")
 }
 
 func GuessRoughlyMatchesAnswer(guess string, answer string) bool {
-	return strutil.Similarity(SimplifyGuess(guess), SimplifyGuess(answer), metrics.NewHamming()) >= 0.8
+	return DefaultMatcher().Matches(guess, answer)
 }
 
-func SimplifyGuess(guess string) string {
-	return trimAllPrefix(strings.ToLower(guess), "a ", "the ")
+// tokenSetSimilarity fuzzy matches the words in each string regardless of order. The result is
+// the harmonic mean of the proportion of each string (by length) that was matched, so a missing
+// word lowers the score without rejecting the guess outright.
+func tokenSetSimilarity(guess string, answer string) float64 {
+	guessTokens, answerTokens := strings.Fields(guess), strings.Fields(answer)
+	lev := metrics.NewLevenshtein()
+
+	matchedGuess := make([]bool, len(guessTokens))
+	matchedAnswer := make([]bool, len(answerTokens))
+	for ai, a := range answerTokens {
+		for gi, g := range guessTokens {
+			if matchedGuess[gi] {
+				continue
+			}
+			if strutil.Similarity(g, a, lev) >= tokenMatchThreshold {
+				matchedGuess[gi] = true
+				matchedAnswer[ai] = true
+				break
+			}
+		}
+	}
+
+	precision := matchedWeight(guessTokens, matchedGuess)
+	recall := matchedWeight(answerTokens, matchedAnswer)
+	if precision+recall == 0 {
+		return 0
+	}
+	return 2 * precision * recall / (precision + recall)
 }
 
-func trimAllPrefix(str string, trim ...string) string {
-	str = strings.TrimSpace(str)
-	for _, v := range trim {
-		str = strings.TrimSpace(strings.TrimPrefix(str, v))
+func matchedWeight(tokens []string, matched []bool) float64 {
+	total, hit := 0, 0
+	for k, t := range tokens {
+		if InStrings(t, fillerWords...) {
+			continue
+		}
+		total += len(t)
+		if matched[k] {
+			hit += len(t)
+		}
+	}
+	if total == 0 {
+		// all filler words
+		for k := range tokens {
+			if !matched[k] {
+				return 0
+			}
+		}
+		return 1
 	}
-	return str
+	return float64(hit) / float64(total)
 }
@@ -76,6 +76,70 @@ func TestGuessRoughlyMatchesAnswer(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "numerals in words match",
+			args: args{
+				guess:  "seven",
+				answer: "Se7en",
+			},
+			want: true,
+		},
+		{
+			name: "diacritics do not matter",
+			args: args{
+				guess:  "amelie",
+				answer: "Amélie",
+			},
+			want: true,
+		},
+		{
+			name: "hyphens do not matter",
+			args: args{
+				guess:  "spiderman",
+				answer: "Spider-Man",
+			},
+			want: true,
+		},
+		{
+			name: "punctuation does not matter",
+			args: args{
+				guess:  "harry potter philosophers stone",
+				answer: "Harry Potter: Philosopher's Stone",
+			},
+			want: true,
+		},
+		{
+			name: "numbers match words",
+			args: args{
+				guess:  "2 fast 2 furious",
+				answer: "two fast two furious",
+			},
+			want: true,
+		},
+		{
+			name: "roman numerals match numbers",
+			args: args{
+				guess:  "rocky 4",
+				answer: "Rocky IV",
+			},
+			want: true,
+		},
+		{
+			name: "roman numerals do not match the wrong number",
+			args: args{
+				guess:  "rocky 3",
+				answer: "Rocky IV",
+			},
+			want: false,
+		},
+		{
+			name: "all leading articles are removed",
+			args: args{
+				guess:  "an american werewolf in london",
+				answer: "American Werewolf in London",
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -85,3 +149,269 @@ func TestGuessRoughlyMatchesAnswer(t *testing.T) {
 		})
 	}
 }
+
+func TestMatcher_Matches(t *testing.T) {
+	type args struct {
+		guess  string
+		answer string
+	}
+	tests := []struct {
+		name    string
+		matcher *Matcher
+		args    args
+		want    bool
+	}{
+		{
+			name:    "nil matcher uses defaults",
+			matcher: nil,
+			args: args{
+				guess:  "bill oddy",
+				answer: "bill oddie",
+			},
+			want: true,
+		},
+		{
+			name:    "digits in words match at a strict threshold",
+			matcher: &Matcher{Threshold: 0.95},
+			args: args{
+				guess:  "seven",
+				answer: "Se7en",
+			},
+			want: true,
+		},
+		{
+			name:    "token set allows missing filler words",
+			matcher: &Matcher{Metric: MetricTokenSet},
+			args: args{
+				guess:  "harry potter philosophers stone",
+				answer: "Harry Potter and the Philosopher's Stone",
+			},
+			want: true,
+		},
+		{
+			name:    "token set allows a missing word",
+			matcher: &Matcher{Metric: MetricTokenSet},
+			args: args{
+				guess:  "mission impossible",
+				answer: "Mission: Impossible - Fallout",
+			},
+			want: true,
+		},
+		{
+			name:    "token set ignores word order",
+			matcher: &Matcher{Metric: MetricTokenSet},
+			args: args{
+				guess:  "bond james",
+				answer: "james bond",
+			},
+			want: true,
+		},
+		{
+			name:    "token set rejects a single word of a long answer",
+			matcher: &Matcher{Metric: MetricTokenSet},
+			args: args{
+				guess:  "rings",
+				answer: "The Lord of the Rings",
+			},
+			want: false,
+		},
+		{
+			name:    "token set tolerates typos in words",
+			matcher: &Matcher{Metric: MetricTokenSet},
+			args: args{
+				guess:  "bill oddy",
+				answer: "bill oddie",
+			},
+			want: true,
+		},
+		{
+			name:    "jaro winkler matches small typos",
+			matcher: &Matcher{Metric: MetricJaroWinkler, Threshold: 0.9},
+			args: args{
+				guess:  "shawshank redemtion",
+				answer: "The Shawshank Redemption",
+			},
+			want: true,
+		},
+		{
+			name:    "stricter threshold rejects typos",
+			matcher: &Matcher{Metric: MetricLevenshtein, Threshold: 0.95},
+			args: args{
+				guess:  "bill oddy",
+				answer: "bill oddie",
+			},
+			want: false,
+		},
+		{
+			name:    "answers made of filler words still match",
+			matcher: &Matcher{Metric: MetricTokenSet},
+			args: args{
+				guess:  "the",
+				answer: "the",
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(tt.args.guess, tt.args.answer); got != tt.want {
+				t.Errorf("Matches() = %v, want %v (similarity %f)", got, tt.want, tt.matcher.Similarity(tt.args.guess, tt.args.answer))
+			}
+		})
+	}
+}
+
+func TestNormalise(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want string
+	}{
+		{name: "lower case", str: "FARGO", want: "fargo"},
+		{name: "diacritics", str: "Amélie", want: "amelie"},
+		{name: "joining punctuation", str: "Spider-Man: Far From Home", want: "spiderman far from home"},
+		{name: "apostrophes", str: "Schindler's List", want: "schindlers list"},
+		{name: "numerals", str: "12 Angry Men", want: "twelve angry men"},
+		{name: "years", str: "1917", want: "one thousand nine hundred and seventeen"},
+		{name: "roman numerals", str: "Rocky II", want: "rocky two"},
+		{name: "digits in words", str: "Se7en", want: "seven"},
+		{name: "digits at the start of words", str: "2Pac", want: "twopac"},
+		{name: "several digits in a word", str: "R2-D2", want: "rtwodtwo"},
+		{name: "first word is not a roman numeral", str: "I, Robot", want: "i robot"},
+		{name: "leading articles", str: "The Thing", want: "thing"},
+		{name: "article only", str: "The", want: "the"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalise(tt.str); got != tt.want {
+				t.Errorf("Normalise() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcher_MatchesAny(t *testing.T) {
+	tests := []struct {
+		name     string
+		guess    string
+		accepted []string
+		rejected []string
+		want     bool
+	}{
+		{
+			name:     "matches answer",
+			guess:    "fellowship of the ring",
+			accepted: []string{"The Fellowship of the Ring", "LOTR"},
+			want:     true,
+		},
+		{
+			name:     "matches alias",
+			guess:    "lotr",
+			accepted: []string{"The Fellowship of the Ring", "LOTR"},
+			want:     true,
+		},
+		{
+			name:     "rejected near miss",
+			guess:    "the fellowship of the rings",
+			accepted: []string{"The Fellowship of the Ring"},
+			rejected: []string{"Fellowship of the Rings"},
+			want:     false,
+		},
+		{
+			name:     "misspelled rejected near miss",
+			guess:    "fellowship of the ringz",
+			accepted: []string{"The Fellowship of the Ring"},
+			rejected: []string{"Fellowship of the Rings"},
+			want:     false,
+		},
+		{
+			name:     "closer to the answer than a rejected near miss",
+			guess:    "the fellowship of the rimg",
+			accepted: []string{"The Fellowship of the Ring"},
+			rejected: []string{"The Fellowship of the Rings"},
+			want:     true,
+		},
+		{
+			name:     "exact answer is not rejected",
+			guess:    "the fellowship of the ring",
+			accepted: []string{"The Fellowship of the Ring"},
+			rejected: []string{"Fellowship of the Rings"},
+			want:     true,
+		},
+		{
+			name:     "no match",
+			guess:    "the two towers",
+			accepted: []string{"The Fellowship of the Ring", "LOTR"},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultMatcher().MatchesAny(tt.guess, tt.accepted, tt.rejected); got != tt.want {
+				t.Errorf("MatchesAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcher_Classify(t *testing.T) {
+	tests := []struct {
+		name     string
+		guess    string
+		accepted []string
+		rejected []string
+		want     GuessResult
+	}{
+		{
+			name:     "correct",
+			guess:    "the lighthouse",
+			accepted: []string{"The Lighthouse"},
+			want:     GuessCorrect,
+		},
+		{
+			name:     "close",
+			guess:    "lightho",
+			accepted: []string{"The Lighthouse"},
+			want:     GuessClose,
+		},
+		{
+			name:     "rejected answers are close",
+			guess:    "the two towers",
+			accepted: []string{"The Fellowship of the Ring"},
+			rejected: []string{"The Two Towers"},
+			want:     GuessClose,
+		},
+		{
+			name:     "misspelled rejected answers are close",
+			guess:    "the fellowship of the ringz",
+			accepted: []string{"The Fellowship of the Ring"},
+			rejected: []string{"The Fellowship of the Rings"},
+			want:     GuessClose,
+		},
+		{
+			name:     "partially right",
+			guess:    "the lord of the rings",
+			accepted: []string{"The Fellowship of the Ring"},
+			want:     GuessPartial,
+		},
+		{
+			name:     "filler words are not partially right",
+			guess:    "the thing",
+			accepted: []string{"The Fellowship of the Ring"},
+			want:     GuessWrong,
+		},
+		{
+			name:     "wrong",
+			guess:    "fargo",
+			accepted: []string{"The Fellowship of the Ring"},
+			want:     GuessWrong,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultMatcher().Classify(tt.guess, tt.accepted, tt.rejected); got != tt.want {
+				t.Errorf("Classify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package util
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normaliser is a single step in the answer normalisation pipeline.
+type Normaliser func(string) string
+
+var joiningPunctuation = regexp.MustCompile(`['’‘\-.]+`)
+var separatingPunctuation = regexp.MustCompile(`[^\p{L}\p{N}\s]+`)
+var digitsInWord = regexp.MustCompile(`[0-9]+`)
+var romanNumeral = regexp.MustCompile(`^(x{0,3})(ix|iv|v?i{0,3})$`)
+
+var leadingArticles = []string{"a", "an", "the"}
+
+// DefaultNormalisers are applied to both the guess and the answer before they are compared.
+var DefaultNormalisers = []Normaliser{
+	strings.ToLower,
+	RemoveDiacritics,
+	RemovePunctuation,
+	RomanNumeralsToNumbers,
+	NumeralsToWords,
+	DigitsInWordsToWords,
+	RemoveLeadingArticles,
+}
+
+// Normalise applies the given normalisers in order. If none are given the DefaultNormalisers are used.
+func Normalise(str string, normalisers ...Normaliser) string {
+	if len(normalisers) == 0 {
+		normalisers = DefaultNormalisers
+	}
+	for _, n := range normalisers {
+		str = n(str)
+	}
+	return strings.Join(strings.Fields(str), " ")
+}
+
+// RemoveDiacritics e.g. Amélie => Amelie
+func RemoveDiacritics(str string) string {
+	out, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), str)
+	if err != nil {
+		return str
+	}
+	return out
+}
+
+// RemovePunctuation drops characters that join words (e.g. Spider-Man => SpiderMan) and replaces
+// all other punctuation with spaces.
+func RemovePunctuation(str string) string {
+	return separatingPunctuation.ReplaceAllString(joiningPunctuation.ReplaceAllString(str, ""), " ")
+}
+
+// RemoveLeadingArticles e.g. the lighthouse => lighthouse
+func RemoveLeadingArticles(str string) string {
+	words := strings.Fields(str)
+	for len(words) > 1 && InStrings(strings.ToLower(words[0]), leadingArticles...) {
+		words = words[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// RomanNumeralsToNumbers converts roman numerals up to 39 to digits e.g. rocky iv => rocky 4.
+// The first word is never converted to avoid mangling names like "I, Robot".
+// Expects lower case input.
+func RomanNumeralsToNumbers(str string) string {
+	words := strings.Fields(str)
+	for k, w := range words {
+		if k == 0 || !romanNumeral.MatchString(w) {
+			continue
+		}
+		words[k] = strconv.Itoa(romanToInt(w))
+	}
+	return strings.Join(words, " ")
+}
+
+// NumeralsToWords converts any whole-number words to their written form e.g. 2 fast => two fast.
+func NumeralsToWords(str string) string {
+	words := strings.Fields(str)
+	for k, w := range words {
+		n, err := strconv.Atoi(w)
+		if err != nil || n < 0 || n > 9999 {
+			continue
+		}
+		words[k] = numberToWords(n)
+	}
+	return strings.Join(words, " ")
+}
+
+// DigitsInWordsToWords converts digits used in place of letters to their written form e.g. se7en => seven.
+// Letters either side of the digits that repeat the start or end of the number are dropped so the result is
+// the word the digits stand in for. Words that are only digits are left to NumeralsToWords.
+func DigitsInWordsToWords(str string) string {
+	words := strings.Fields(str)
+	for k, w := range words {
+		if strings.ContainsFunc(w, unicode.IsLetter) && strings.ContainsFunc(w, unicode.IsDigit) {
+			words[k] = digitsToWords(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func digitsToWords(word string) string {
+	sb := &strings.Builder{}
+	rest := word
+	for {
+		loc := digitsInWord.FindStringIndex(rest)
+		if loc == nil {
+			sb.WriteString(rest)
+			return sb.String()
+		}
+		n, err := strconv.Atoi(rest[loc[0]:loc[1]])
+		if err != nil || n > 9999 {
+			sb.WriteString(rest[:loc[1]])
+			rest = rest[loc[1]:]
+			continue
+		}
+		written := strings.ReplaceAll(numberToWords(n), " ", "")
+		before := rest[:loc[0]]
+		sb.WriteString(strings.TrimSuffix(before, overlapsStart(before, written)))
+		sb.WriteString(written)
+
+		rest = rest[loc[1]:]
+		after := rest
+		if next := digitsInWord.FindStringIndex(rest); next != nil {
+			after = rest[:next[0]]
+		}
+		rest = rest[len(overlapsEnd(after, written)):]
+	}
+}
+
+// overlapsStart returns the longest suffix of str that is also a prefix of word e.g. se, seven => se.
+func overlapsStart(str string, word string) string {
+	for i := range len(str) {
+		if strings.HasPrefix(word, str[i:]) {
+			return str[i:]
+		}
+	}
+	return ""
+}
+
+// overlapsEnd returns the longest prefix of str that is also a suffix of word e.g. en, seven => en.
+func overlapsEnd(str string, word string) string {
+	for i := len(str); i > 0; i-- {
+		if strings.HasSuffix(word, str[:i]) {
+			return str[:i]
+		}
+	}
+	return ""
+}
+
+func romanToInt(numeral string) int {
+	values := map[rune]int{'i': 1, 'v': 5, 'x': 10}
+	total := 0
+	chars := []rune(numeral)
+	for k, r := range chars {
+		if k+1 < len(chars) && values[r] < values[chars[k+1]] {
+			total -= values[r]
+		} else {
+			total += values[r]
+		}
+	}
+	return total
+}
+
+var smallNumbers = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+	"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var tens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+func numberToWords(n int) string {
+	switch {
+	case n < 20:
+		return smallNumbers[n]
+	case n < 100:
+		if n%10 == 0 {
+			return tens[n/10]
+		}
+		return tens[n/10] + " " + smallNumbers[n%10]
+	case n < 1000:
+		if n%100 == 0 {
+			return smallNumbers[n/100] + " hundred"
+		}
+		return smallNumbers[n/100] + " hundred and " + numberToWords(n%100)
+	default:
+		if n%1000 == 0 {
+			return numberToWords(n/1000) + " thousand"
+		}
+		if n%1000 < 100 {
+			return numberToWords(n/1000) + " thousand and " + numberToWords(n%1000)
+		}
+		return numberToWords(n/1000) + " thousand " + numberToWords(n%1000)
+	}
+}
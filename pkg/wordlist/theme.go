@@ -0,0 +1,195 @@
+package wordlist
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/warmans/gamesmaster/pkg/dictionary"
+)
+
+var titleSuffix = regexp.MustCompile(`(\s+-\s+.*|\s*\(.*\)|\s*\[.*\])$`)
+var lettersOnly = regexp.MustCompile(`^[A-Z]+$`)
+
+var templateFuncs = template.FuncMap{
+	"anagram": anagram,
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+}
+
+// Theme generates answers and clues from a list of entries using templates. Each entry is a set of named
+// fields e.g. Song, Artist and Album which can be referenced in the templates e.g. "Artist of '{{.Song}}'".
+type Theme struct {
+	Name    string
+	Answer  string
+	Clue    string
+	Entries []map[string]string
+}
+
+// BuiltinThemes are generated from the bundled dictionaries.
+func BuiltinThemes() map[string]*Theme {
+	var tracks []map[string]string
+	for _, v := range dictionary.Tracks {
+		tracks = append(tracks, map[string]string{
+			"Song":   CleanTitle(v.Name),
+			"Artist": v.Artist,
+			"Album":  CleanTitle(v.Album),
+		})
+	}
+	words := func(list []string) []map[string]string {
+		var entries []map[string]string
+		for _, v := range list {
+			entries = append(entries, map[string]string{"Word": v})
+		}
+		return entries
+	}
+	return map[string]*Theme{
+		"artists": {Name: "artists", Answer: "{{.Artist}}", Clue: "Artist of '{{.Song}}'", Entries: tracks},
+		"songs":   {Name: "songs", Answer: "{{.Song}}", Clue: "Song by {{.Artist}} from '{{.Album}}'", Entries: tracks},
+		"albums":  {Name: "albums", Answer: "{{.Album}}", Clue: "Album by {{.Artist}} featuring '{{.Song}}'", Entries: tracks},
+		"nouns":   {Name: "nouns", Answer: "{{.Word}}", Clue: "Anagram of {{anagram .Word}}", Entries: words(dictionary.Nouns)},
+		"objects": {Name: "objects", Answer: "{{.Word}}", Clue: "Anagram of {{anagram .Word}}", Entries: words(dictionary.Objects)},
+	}
+}
+
+// BuiltinThemeNames returns the names of the builtin themes in order.
+func BuiltinThemeNames() []string {
+	var names []string
+	for k := range BuiltinThemes() {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadTheme returns the builtin theme with the given name or loads a themed list from a file. The file can either
+// be a JSON array of objects or a CSV where the header row gives the field names.
+func LoadTheme(nameOrPath string) (*Theme, error) {
+	if theme, ok := BuiltinThemes()[nameOrPath]; ok {
+		return theme, nil
+	}
+	f, err := os.Open(nameOrPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s is not a file or builtin theme (%s)", nameOrPath, strings.Join(BuiltinThemeNames(), ", "))
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	theme := &Theme{Name: strings.TrimSuffix(path.Base(nameOrPath), path.Ext(nameOrPath))}
+	switch strings.ToLower(path.Ext(nameOrPath)) {
+	case ".json":
+		if err := json.NewDecoder(f).Decode(&theme.Entries); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", nameOrPath, err)
+		}
+	case ".csv":
+		records, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", nameOrPath, err)
+		}
+		if len(records) < 2 {
+			return nil, fmt.Errorf("%s must have a header row and at least one entry", nameOrPath)
+		}
+		for _, record := range records[1:] {
+			entry := map[string]string{}
+			for k, field := range records[0] {
+				if k < len(record) {
+					entry[strings.TrimSpace(field)] = strings.TrimSpace(record[k])
+				}
+			}
+			theme.Entries = append(theme.Entries, entry)
+		}
+	default:
+		return nil, fmt.Errorf("unknown theme format: %s", nameOrPath)
+	}
+	return theme, nil
+}
+
+// Rows picks up to n random entries from the theme and renders their answers and clues. Entries are
+// skipped if the answer contains anything other than letters, is a duplicate or fails validation.
+func (t *Theme) Rows(n int, gridSize int) ([]Row, error) {
+	if t.Answer == "" || t.Clue == "" {
+		return nil, fmt.Errorf("theme %s needs an answer and clue template", t.Name)
+	}
+	answerTmpl, err := template.New("answer").Funcs(templateFuncs).Option("missingkey=error").Parse(t.Answer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid answer template: %w", err)
+	}
+	clueTmpl, err := template.New("clue").Funcs(templateFuncs).Option("missingkey=error").Parse(t.Clue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clue template: %w", err)
+	}
+
+	var rows []Row
+	seen := map[string]struct{}{}
+	for _, idx := range rand.Perm(len(t.Entries)) {
+		if len(rows) >= n {
+			break
+		}
+		answer, err := execute(answerTmpl, t.Entries[idx])
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", idx+1, err)
+		}
+		clue, err := execute(clueTmpl, t.Entries[idx])
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", idx+1, err)
+		}
+		normalised, _ := Normalise(answer)
+		if _, ok := seen[normalised]; ok || !lettersOnly.MatchString(normalised) {
+			continue
+		}
+		row := Row{Line: idx + 1, Answer: answer, Clue: clue}
+		if issues := Validate([]Row{row}, gridSize, nil); len(issues) > 0 || len(normalised) < 3 {
+			continue
+		}
+		seen[normalised] = struct{}{}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("theme %s did not produce any usable words", t.Name)
+	}
+	return rows, nil
+}
+
+func execute(tmpl *template.Template, entry map[string]string) (string, error) {
+	buff := &bytes.Buffer{}
+	if err := tmpl.Execute(buff, entry); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buff.String()), nil
+}
+
+// CleanTitle removes suffixes from song and album titles e.g. "A Forest - 2006 Remaster" => "A Forest".
+func CleanTitle(title string) string {
+	for {
+		cleaned := strings.TrimSpace(titleSuffix.ReplaceAllString(title, ""))
+		if cleaned == title || cleaned == "" {
+			return title
+		}
+		title = cleaned
+	}
+}
+
+// anagram shuffles the letters of the word until it is different to the original (if possible).
+func anagram(word string) string {
+	normalised, _ := Normalise(word)
+	letters := []rune(normalised)
+	for range 10 {
+		rand.Shuffle(len(letters), func(i, j int) {
+			letters[i], letters[j] = letters[j], letters[i]
+		})
+		if string(letters) != normalised {
+			break
+		}
+	}
+	return string(letters)
+}
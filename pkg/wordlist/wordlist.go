@@ -0,0 +1,254 @@
+package wordlist
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/warmans/gamesmaster/pkg/dictionary"
+	"github.com/warmans/go-crossword/v2"
+)
+
+type Format string
+
+const (
+	FormatCSV      Format = "csv"
+	FormatTSV      Format = "tsv"
+	FormatMarkdown Format = "md"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+var punctuation = regexp.MustCompile(`[^A-Za-z0-9\s]+`)
+var spaces = regexp.MustCompile(`\s+`)
+var markdownSeparator = regexp.MustCompile(`^\|?[\s:|-]+\|?$`)
+
+// Row is a single answer and clue read from a list.
+type Row struct {
+	Line   int
+	Answer string
+	Clue   string
+}
+
+// Issue is a problem found with a row. Rows with errors cannot be used in a crossword.
+type Issue struct {
+	Row      Row
+	Severity Severity
+	Message  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("line %d (%s): %s: %s", i.Row.Line, i.Row.Answer, i.Severity, i.Message)
+}
+
+// FormatFromPath guesses the format from the file extension.
+func FormatFromPath(filePath string) (Format, error) {
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".tsv", ".tab":
+		return FormatTSV, nil
+	case ".md", ".markdown":
+		return FormatMarkdown, nil
+	}
+	return "", fmt.Errorf("unknown word list format: %s", filePath)
+}
+
+// Load reads the answers and clues from a file. The first column is the answer and the second is the clue.
+// A header row is skipped if the first column is "answer" or "word".
+func Load(filePath string, format Format) ([]Row, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f, format)
+}
+
+func Read(r io.Reader, format Format) ([]Row, error) {
+	var records [][]string
+	var lines []int
+	var err error
+	switch format {
+	case FormatCSV, FormatTSV:
+		records, lines, err = readDelimited(r, format)
+	case FormatMarkdown:
+		records, lines, err = readMarkdown(r)
+	default:
+		return nil, fmt.Errorf("unknown word list format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for k, record := range records {
+		if len(record) == 0 || strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		if k == 0 && slices.Contains([]string{"answer", "word"}, strings.ToLower(strings.TrimSpace(record[0]))) {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected answer and clue columns", lines[k])
+		}
+		rows = append(rows, Row{
+			Line:   lines[k],
+			Answer: strings.TrimSpace(record[0]),
+			Clue:   strings.TrimSpace(record[1]),
+		})
+	}
+	return rows, nil
+}
+
+func readDelimited(r io.Reader, format Format) ([][]string, []int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if format == FormatTSV {
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+	var records [][]string
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+	return records, lines, nil
+}
+
+func readMarkdown(r io.Reader) ([][]string, []int, error) {
+	var records [][]string
+	var lines []int
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(text, "|") || markdownSeparator.MatchString(text) {
+			continue
+		}
+		cells := strings.Split(strings.Trim(text, "|"), "|")
+		for k := range cells {
+			cells[k] = strings.TrimSpace(cells[k])
+		}
+		records = append(records, cells)
+		lines = append(lines, line)
+	}
+	return records, lines, scanner.Err()
+}
+
+// Normalise strips punctuation and spaces from the answer and upper-cases it. The letter counts of
+// each word in the answer are also returned e.g. "The Thing" => THETHING (3,5).
+func Normalise(answer string) (string, []int) {
+	words := strings.Fields(spaces.ReplaceAllString(punctuation.ReplaceAllString(answer, ""), " "))
+	counts := make([]int, len(words))
+	for k, w := range words {
+		counts[k] = len(w)
+	}
+	return strings.ToUpper(strings.Join(words, "")), counts
+}
+
+// Validate checks the rows can be used in a crossword of the given size. Unknown words are only
+// checked if a dictionary is given.
+func Validate(rows []Row, gridSize int, dict dictionary.Words) []Issue {
+	var issues []Issue
+	seen := map[string]Row{}
+	for _, row := range rows {
+		answer, _ := Normalise(row.Answer)
+		if answer == "" {
+			issues = append(issues, Issue{Row: row, Severity: SeverityError, Message: "answer is empty"})
+			continue
+		}
+		if row.Clue == "" {
+			issues = append(issues, Issue{Row: row, Severity: SeverityError, Message: "clue is empty"})
+		}
+		if len(answer) > gridSize {
+			issues = append(issues, Issue{Row: row, Severity: SeverityError, Message: fmt.Sprintf("answer is longer than the grid size (%d)", gridSize)})
+		}
+		if len(answer) < 2 {
+			issues = append(issues, Issue{Row: row, Severity: SeverityError, Message: "answer must have at least 2 letters"})
+		}
+		if prev, ok := seen[answer]; ok {
+			issues = append(issues, Issue{Row: row, Severity: SeverityError, Message: fmt.Sprintf("duplicate of line %d", prev.Line)})
+		} else {
+			seen[answer] = row
+		}
+		if leaked := leakedWords(row); len(leaked) > 0 {
+			issues = append(issues, Issue{Row: row, Severity: SeverityWarning, Message: fmt.Sprintf("clue contains the answer (%s)", strings.Join(leaked, ", "))})
+		}
+		if dict != nil {
+			var unknown []string
+			for _, w := range strings.Fields(punctuation.ReplaceAllString(row.Answer, "")) {
+				if !dict.Contains(w) {
+					unknown = append(unknown, strings.ToUpper(w))
+				}
+			}
+			if len(unknown) > 0 {
+				issues = append(issues, Issue{Row: row, Severity: SeverityWarning, Message: fmt.Sprintf("not in dictionary: %s", strings.Join(unknown, ", "))})
+			}
+		}
+	}
+	return issues
+}
+
+// leakedWords returns any words of the answer that also appear in the clue. Short words like "the" are ignored
+// unless they are the whole answer.
+func leakedWords(row Row) []string {
+	clueWords := strings.Fields(strings.ToUpper(punctuation.ReplaceAllString(row.Clue, " ")))
+	answerWords := strings.Fields(strings.ToUpper(punctuation.ReplaceAllString(row.Answer, "")))
+
+	var leaked []string
+	for _, w := range answerWords {
+		if len(w) < 4 && len(answerWords) > 1 {
+			continue
+		}
+		if slices.Contains(clueWords, w) && !slices.Contains(leaked, w) {
+			leaked = append(leaked, w)
+		}
+	}
+	// multi-word answers may also appear in the clue without spaces e.g. "Goodfellas" for "Good Fellas"
+	if joined, _ := Normalise(row.Answer); len(answerWords) > 1 && slices.Contains(clueWords, joined) {
+		leaked = append(leaked, joined)
+	}
+	return leaked
+}
+
+// HasErrors returns true if any of the issues are errors.
+func HasErrors(issues []Issue) bool {
+	return slices.ContainsFunc(issues, func(i Issue) bool { return i.Severity == SeverityError })
+}
+
+// Words converts the rows to a crossword word list.
+func Words(rows []Row) []crossword.Word {
+	words := make([]crossword.Word, 0, len(rows))
+	for _, row := range rows {
+		answer, counts := Normalise(row.Answer)
+		words = append(words, crossword.Word{
+			Word:          answer,
+			Clue:          row.Clue,
+			LettersCounts: counts,
+		})
+	}
+	return words
+}
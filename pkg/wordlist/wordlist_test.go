@@ -0,0 +1,128 @@
+package wordlist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/warmans/gamesmaster/pkg/dictionary"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		input  string
+	}{
+		{
+			name:   "csv",
+			format: FormatCSV,
+			input:  "answer,clue\n\"The Thing\",\"Carpenter, 1982\"\nAlien,Space horror\n",
+		},
+		{
+			name:   "tsv",
+			format: FormatTSV,
+			input:  "The Thing\tCarpenter, 1982\nAlien\tSpace horror\n",
+		},
+		{
+			name:   "markdown",
+			format: FormatMarkdown,
+			input:  "Films\n\n| Answer | Clue |\n|--------|------|\n| The Thing | Carpenter, 1982 |\n| Alien | Space horror |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Read(strings.NewReader(tt.input), tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rows) != 2 {
+				t.Fatalf("expected 2 rows got %d", len(rows))
+			}
+			if rows[0].Answer != "The Thing" || rows[0].Clue != "Carpenter, 1982" || rows[1].Answer != "Alien" {
+				t.Fatalf("unexpected rows: %+v", rows)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	rows := []Row{
+		{Line: 1, Answer: "The Thing", Clue: "Carpenter, 1982"},
+		{Line: 2, Answer: "Fargo", Clue: "Not set in Fargo"},
+		{Line: 3, Answer: "the thing!", Clue: "Remake"},
+		{Line: 4, Answer: "Supercalifragilistic", Clue: "Too long"},
+		{Line: 5, Answer: "Zzqx", Clue: "Nonsense"},
+	}
+	dict := dictionary.Words{"THE": {}, "THING": {}, "FARGO": {}, "SUPERCALIFRAGILISTIC": {}}
+
+	got := map[int][]Severity{}
+	for _, v := range Validate(rows, 15, dict) {
+		got[v.Row.Line] = append(got[v.Row.Line], v.Severity)
+	}
+	expected := map[int][]Severity{
+		2: {SeverityWarning},
+		3: {SeverityError},
+		4: {SeverityError},
+		5: {SeverityWarning},
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected issues %v got %v", expected, got)
+	}
+	for line, severities := range expected {
+		if strings.Join(toStrings(got[line]), ",") != strings.Join(toStrings(severities), ",") {
+			t.Fatalf("line %d: expected %v got %v", line, severities, got[line])
+		}
+	}
+}
+
+func TestNormalise(t *testing.T) {
+	answer, counts := Normalise("  Don't Look   Now! ")
+	if answer != "DONTLOOKNOW" {
+		t.Fatalf("unexpected answer %s", answer)
+	}
+	if len(counts) != 3 || counts[0] != 4 || counts[1] != 4 || counts[2] != 3 {
+		t.Fatalf("unexpected counts %v", counts)
+	}
+}
+
+func toStrings(s []Severity) []string {
+	out := make([]string, len(s))
+	for k, v := range s {
+		out[k] = string(v)
+	}
+	return out
+}
+
+func TestTheme_Rows(t *testing.T) {
+	theme := &Theme{
+		Name:   "test",
+		Answer: "{{.Artist}}",
+		Clue:   "Artist of '{{.Song}}'",
+		Entries: []map[string]string{
+			{"Artist": "Elvis Costello", "Song": "Allison"},
+			{"Artist": "Elvis Costello", "Song": "Alison"},
+			{"Artist": "Blur", "Song": "Blur"},
+			{"Artist": "2Pac", "Song": "California Love"},
+		},
+	}
+	rows, err := theme.Rows(10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Answer != "Elvis Costello" || !strings.HasPrefix(rows[0].Clue, "Artist of 'Al") {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestCleanTitle(t *testing.T) {
+	for title, expected := range map[string]string{
+		"A Forest - 2006 Remaster":         "A Forest",
+		"Positivity (Remastered)":          "Positivity",
+		"Orange Crush - Remastered 2013":   "Orange Crush",
+		"(What's the Story) Morning Glory": "(What's the Story) Morning Glory",
+	} {
+		if got := CleanTitle(title); got != expected {
+			t.Errorf("%s: expected %s got %s", title, expected, got)
+		}
+	}
+}
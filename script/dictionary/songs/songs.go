@@ -41,6 +41,26 @@ var Artists = []string{
 }
 `))
 
+var tracksTmpl = template.Must(template.New("tracks").Parse(`package dictionary
+
+type Track struct {
+	Name   string
+	Artist string
+	Album  string
+}
+
+var Tracks = []Track{
+{{range $track := .Tracks}}	{Name: {{printf "%q" $track.Name}}, Artist: {{printf "%q" $track.Artist}}, Album: {{printf "%q" $track.Album}}},
+{{end}}}
+`))
+
+type track struct {
+	Name   string
+	Artist string
+	Album  string
+}
+
+// e.g. songs.go tracks > tracks.gen.go
 func main() {
 
 	_, filename, _, ok := runtime.Caller(0)
@@ -61,6 +81,23 @@ func main() {
 		panic(err.Error())
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "tracks" {
+		tracks := []track{}
+		for _, v := range songMeta.Songs {
+			if len(v.Track.Artists) == 0 {
+				continue
+			}
+			tracks = append(tracks, track{Name: v.Track.Name, Artist: v.Track.Artists[0].Name, Album: v.Track.AlbumName})
+		}
+		slices.SortFunc(tracks, func(a, b track) int {
+			return strings.Compare(a.Artist+a.Name, b.Artist+b.Name)
+		})
+		if err := tracksTmpl.Execute(os.Stdout, struct{ Tracks []track }{Tracks: tracks}); err != nil {
+			panic("failed to execute template: " + err.Error())
+		}
+		return
+	}
+
 	songs := []string{}
 	artists := []string{}
 	for _, v := range songMeta.Songs {